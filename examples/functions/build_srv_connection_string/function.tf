@@ -0,0 +1,14 @@
+resource "kubernetes_secret" "app" {
+  metadata {
+    name = "mongodb"
+  }
+  data = {
+    uri = provider::mongodbatlas::build_srv_connection_string(
+      mongodbatlas_advanced_cluster.this.connection_strings.standard_srv,
+      mongodbatlas_database_user.app.username,
+      var.app_password,
+      "orders",
+      { retryWrites = "true", w = "majority" },
+    )
+  }
+}
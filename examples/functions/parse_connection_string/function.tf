@@ -0,0 +1,7 @@
+locals {
+  connection = provider::mongodbatlas::parse_connection_string(mongodbatlas_advanced_cluster.this.connection_strings.standard_srv)
+}
+
+output "cluster_host" {
+  value = local.connection.hosts[0]
+}
@@ -0,0 +1,8 @@
+locals {
+  cluster = provider::mongodbatlas::parse_import_id(var.cluster_import_id, "{project_id}-{cluster_name}")
+}
+
+data "mongodbatlas_advanced_cluster" "this" {
+  project_id = local.cluster.project_id
+  name       = local.cluster.cluster_name
+}
@@ -0,0 +1,37 @@
+locals {
+  replication_specs = [{
+    region_configs = [{
+      provider_name   = "AWS"
+      region_name     = "US_EAST_1"
+      priority        = 7
+      electable_specs = { instance_size = var.instance_size, node_count = 3 }
+      analytics_specs = { instance_size = "M10", node_count = 1 }
+    }]
+  }]
+}
+
+data "mongodbatlas_cluster_cost_estimate" "this" {
+  replication_specs = [for spec in local.replication_specs : {
+    region_configs = [for config in spec.region_configs : {
+      provider_name   = config.provider_name
+      region_name     = config.region_name
+      electable_specs = config.electable_specs
+      analytics_specs = config.analytics_specs
+    }]
+  }]
+}
+
+resource "mongodbatlas_advanced_cluster" "this" {
+  project_id        = var.project_id
+  name              = "cost-estimate-example"
+  cluster_type      = "REPLICASET"
+  replication_specs = local.replication_specs
+}
+
+output "hourly_cost" {
+  value = data.mongodbatlas_cluster_cost_estimate.this.hourly_cost
+}
+
+output "monthly_cost" {
+  value = "${data.mongodbatlas_cluster_cost_estimate.this.monthly_cost} ${data.mongodbatlas_cluster_cost_estimate.this.currency}"
+}
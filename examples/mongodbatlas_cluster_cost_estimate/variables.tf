@@ -0,0 +1,20 @@
+variable "atlas_client_id" {
+  description = "MongoDB Atlas Service Account Client ID"
+  type        = string
+  default     = ""
+}
+variable "atlas_client_secret" {
+  description = "MongoDB Atlas Service Account Client Secret"
+  type        = string
+  sensitive   = true
+  default     = ""
+}
+variable "project_id" {
+  description = "Atlas Project ID"
+  type        = string
+}
+variable "instance_size" {
+  description = "Instance size of the electable nodes"
+  type        = string
+  default     = "M30"
+}
@@ -0,0 +1,57 @@
+resource "mongodbatlas_custom_db_role" "orders_writer" {
+  project_id = var.project_id
+  role_name  = "ordersWriter"
+
+  actions {
+    action = "INSERT"
+    resources {
+      collection_name = "orders"
+      database_name   = "sales"
+    }
+  }
+}
+
+resource "mongodbatlas_custom_db_role" "sales_analyst" {
+  project_id = var.project_id
+  role_name  = "salesAnalyst"
+
+  actions {
+    action = "FIND"
+    resources {
+      collection_name = ""
+      database_name   = "sales"
+    }
+  }
+
+  inherited_roles {
+    role_name     = mongodbatlas_custom_db_role.orders_writer.role_name
+    database_name = "admin"
+  }
+
+  inherited_roles {
+    role_name     = "read"
+    database_name = "inventory"
+  }
+}
+
+data "mongodbatlas_database_effective_permissions" "role" {
+  project_id = var.project_id
+  role_name  = mongodbatlas_custom_db_role.sales_analyst.role_name
+}
+
+data "mongodbatlas_database_effective_permissions" "user" {
+  project_id = var.project_id
+  username   = var.username
+}
+
+output "role_privileges" {
+  value = {
+    for action in data.mongodbatlas_database_effective_permissions.role.actions : action.action => [
+      for resource in action.resources : resource.cluster ? "cluster" : "${resource.database_name == "" ? "*" : resource.database_name}.${resource.collection_name == "" ? "*" : resource.collection_name}"
+    ]
+  }
+}
+
+output "user_roles" {
+  value = [for role in data.mongodbatlas_database_effective_permissions.user.roles : "${role.role_name}@${role.database_name}"]
+}
@@ -0,0 +1,19 @@
+variable "atlas_client_id" {
+  description = "MongoDB Atlas Service Account Client ID"
+  type        = string
+  default     = ""
+}
+variable "atlas_client_secret" {
+  description = "MongoDB Atlas Service Account Client Secret"
+  type        = string
+  sensitive   = true
+  default     = ""
+}
+variable "project_id" {
+  description = "Atlas Project ID"
+  type        = string
+}
+variable "username" {
+  description = "Username of the database user to inspect"
+  type        = string
+}
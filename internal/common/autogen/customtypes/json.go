@@ -0,0 +1,144 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/schemafunc"
+)
+
+/*
+	Custom JSON string type used for all the JSON attributes, so their values are compared with the same semantic equality
+	as the DiffSuppressFunc of SDKv2 JSON attributes (see schemafunc.EqualJSON): key order, whitespace, number representation
+	and MongoDB Extended JSON representation (canonical or relaxed) don't produce diffs.
+	Custom types docs: https://developer.hashicorp.com/terraform/plugin/framework/handling-data/types/custom
+
+	Usage:
+		- Schema definition:
+			"sample_json": schema.StringAttribute{
+				...
+				CustomType: customtypes.JSONType{},
+			}
+
+		- TF Models:
+			type TFModel struct {
+				SampleJSON customtypes.JSONValue `tfsdk:"sample_json"`
+				...
+			}
+*/
+
+var (
+	_ basetypes.StringTypable                    = JSONType{}
+	_ basetypes.StringValuableWithSemanticEquals = JSONValue{}
+	_ xattr.ValidateableAttribute                = JSONValue{}
+)
+
+type JSONType struct {
+	basetypes.StringType
+}
+
+func (t JSONType) Equal(o attr.Type) bool {
+	other, ok := o.(JSONType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (JSONType) String() string {
+	return "JSONType"
+}
+
+func (t JSONType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return JSONValue{StringValue: in}, nil
+}
+
+func (t JSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t JSONType) ValueType(_ context.Context) attr.Value {
+	return JSONValue{}
+}
+
+type JSONValue struct {
+	basetypes.StringValue
+}
+
+func NewJSONValue(value string) JSONValue {
+	return JSONValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewJSONValueNull() JSONValue {
+	return JSONValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewJSONValueUnknown() JSONValue {
+	return JSONValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+func (v JSONValue) Type(_ context.Context) attr.Type {
+	return JSONType{}
+}
+
+func (v JSONValue) Equal(o attr.Value) bool {
+	other, ok := o.(JSONValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v JSONValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newValue, ok := newValuable.(JSONValue)
+	if !ok {
+		return false, diag.Diagnostics{diag.NewErrorDiagnostic(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("unexpected value type: expected %T, got %T", v, newValuable),
+		)}
+	}
+	oldJSON, err := schemafunc.NormalizeJSON(v.ValueString())
+	if err != nil {
+		return false, nil // The prior value is not valid JSON, use the new value.
+	}
+	newJSON, err := schemafunc.NormalizeJSON(newValue.ValueString())
+	if err != nil {
+		return false, nil
+	}
+	return reflect.DeepEqual(oldJSON, newJSON), nil
+}
+
+func (v JSONValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	if _, err := schemafunc.NormalizeJSON(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON String Value",
+			fmt.Sprintf("A string value was provided that is not valid JSON: %s\n\nGiven Value: %s", err, v.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,3 @@
+package autogen
+
+var CallAPIWithRetryForTest = callAPIWithRetry
@@ -7,14 +7,18 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/cleanup"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/concurrency"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/retrystrategy"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
@@ -31,6 +35,10 @@ const (
 	opRead                   = "Read"
 	opUpdate                 = "Update"
 	opDelete                 = "Delete"
+
+	defaultRetryTimeout    = 5 * time.Minute
+	defaultRetryMinTimeout = 10 * time.Second
+	maxRetryBackoff        = 1 * time.Minute
 )
 
 type WaitReq struct {
@@ -42,6 +50,19 @@ type WaitReq struct {
 	MinTimeoutSeconds int
 	DelaySeconds      int
 }
+
+// RetryReq retries an API call that fails with a transient error, e.g. a 409 while another change is being applied.
+// The call is retried with exponential backoff starting at TimeConfig.MinTimeout until TimeConfig.Timeout is reached.
+// MaxAttempts includes the first call, 0 means that only the timeout limits the attempts.
+// POST and PATCH calls are only retried if AllowNonIdempotent is true, as repeating them could apply the change twice.
+type RetryReq struct {
+	ErrorCodes         []string
+	StatusCodes        []int
+	TimeConfig         retrystrategy.TimeConfig
+	MaxAttempts        int
+	AllowNonIdempotent bool
+}
+
 type HandleCreateReq struct {
 	Hooks                 any
 	Resp                  *resource.CreateResponse
@@ -50,6 +71,8 @@ type HandleCreateReq struct {
 	CallParams            *config.APICallParams
 	DeleteReq             func(model any) *HandleDeleteReq
 	Wait                  *WaitReq
+	Retry                 *RetryReq
+	OperationLock         string // operation lock key with path params placeholders, e.g. "project:{groupId}:networking"
 	DeleteOnCreateTimeout bool
 }
 
@@ -61,7 +84,9 @@ func HandleCreate(ctx context.Context, req HandleCreateReq) {
 		return
 	}
 
-	callResult := callCreateWithHooks(ctx, req.Client, *req.CallParams, bodyReq, req.Hooks)
+	unlock := lockOperation(req.OperationLock, req.CallParams)
+	callResult := callCreateWithHooks(ctx, req.Client, *req.CallParams, bodyReq, req.Retry, req.Hooks)
+	unlock()
 	if callResult.Err != nil {
 		addError(d, opCreate, errCallingAPI, callResult.Err)
 		return
@@ -239,12 +264,14 @@ func (p *paginatedAPIResponse) GetTotalCount() int {
 }
 
 type HandleUpdateReq struct {
-	Hooks      any
-	Resp       *resource.UpdateResponse
-	Client     *config.MongoDBClient
-	Plan       any
-	CallParams *config.APICallParams
-	Wait       *WaitReq
+	Hooks         any
+	Resp          *resource.UpdateResponse
+	Client        *config.MongoDBClient
+	Plan          any
+	CallParams    *config.APICallParams
+	Wait          *WaitReq
+	Retry         *RetryReq
+	OperationLock string
 }
 
 func HandleUpdate(ctx context.Context, req HandleUpdateReq) {
@@ -254,7 +281,9 @@ func HandleUpdate(ctx context.Context, req HandleUpdateReq) {
 		addError(d, opUpdate, errBuildingAPIRequest, err)
 		return
 	}
-	callResult := callUpdateWithHooks(ctx, req.Client, *req.CallParams, bodyReq, req.Hooks)
+	unlock := lockOperation(req.OperationLock, req.CallParams)
+	callResult := callUpdateWithHooks(ctx, req.Client, *req.CallParams, bodyReq, req.Retry, req.Hooks)
+	unlock()
 	if callResult.Err != nil {
 		addError(d, opUpdate, errCallingAPI, callResult.Err)
 		return
@@ -283,7 +312,9 @@ type HandleDeleteReq struct {
 	State             any
 	CallParams        *config.APICallParams
 	Wait              *WaitReq
+	Retry             *RetryReq
 	StaticRequestBody string
+	OperationLock     string
 	ResetsToDefaults  bool
 }
 
@@ -328,6 +359,13 @@ func handleWaitDelete(ctx context.Context, wait *WaitReq, client *config.MongoDB
 	return nil
 }
 
+// lockOperation waits for other operations with the same lock key in the provider and locks it until the returned function is called.
+// The lock is only kept during the API call changing the resource so operations that Atlas doesn't allow to run concurrently are serialized,
+// waiting for the changes is done without the lock so long waits don't block other resources.
+func lockOperation(keyTemplate string, callParams *config.APICallParams) (unlock func()) {
+	return concurrency.LockOperation(concurrency.ExpandOperationKey(keyTemplate, callParams.PathParams))
+}
+
 func addError(d *diag.Diagnostics, opName, errSummary string, err error) {
 	d.AddError(fmt.Sprintf("Error %s in %s", errSummary, opName), err.Error())
 }
@@ -353,6 +391,69 @@ func callAPI(ctx context.Context, client *config.MongoDBClient, callParams confi
 	return APICallResult{Body: bodyResp, Resp: apiResp}
 }
 
+// callAPIWithRetry makes a request to the API like callAPI, retrying it while it fails with an error matching the retry policy.
+// The last result is returned when the attempts are exhausted, the timeout is reached or the context is done.
+func callAPIWithRetry(ctx context.Context, client *config.MongoDBClient, callParams config.APICallParams, bodyReq []byte, retryReq *RetryReq) APICallResult {
+	callResult := callAPI(ctx, client, callParams, bodyReq)
+	if retryReq == nil || !retryReq.allowsMethod(callParams.Method) {
+		return callResult
+	}
+	timeout := retryReq.TimeConfig.Timeout
+	if timeout <= 0 {
+		timeout = defaultRetryTimeout
+	}
+	backoff := retryReq.TimeConfig.MinTimeout
+	if backoff <= 0 {
+		backoff = defaultRetryMinTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	for attempt := 1; retryReq.isRetryable(callResult); attempt++ {
+		if retryReq.MaxAttempts > 0 && attempt >= retryReq.MaxAttempts || time.Now().Add(backoff).After(deadline) {
+			break
+		}
+		tflog.Debug(ctx, "Retrying API call after transient error", map[string]any{
+			"method":  callParams.Method,
+			"path":    callParams.RelativePath,
+			"attempt": attempt + 1,
+			"backoff": backoff.String(),
+			"error":   callResult.Err.Error(),
+		})
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return callResult
+		case <-timer.C:
+		}
+		callResult = callAPI(ctx, client, callParams, bodyReq)
+		backoff = min(backoff*2, maxRetryBackoff)
+	}
+	return callResult
+}
+
+// isRetryable returns if the API call failed with one of the status codes or Atlas error codes of the retry policy.
+func (r *RetryReq) isRetryable(callResult APICallResult) bool {
+	if callResult.Err == nil {
+		return false
+	}
+	if callResult.Resp != nil && slices.Contains(r.StatusCodes, callResult.Resp.StatusCode) {
+		return true
+	}
+	return slices.ContainsFunc(r.ErrorCodes, func(errorCode string) bool {
+		return admin.IsErrorCode(callResult.Err, errorCode)
+	})
+}
+
+// allowsMethod returns if calls with the HTTP method can be retried, non-idempotent methods are only retried if the policy opts in.
+func (r *RetryReq) allowsMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPatch:
+		return r.AllowNonIdempotent
+	default:
+		return true
+	}
+}
+
 // callAPIWithoutBody makes a request to the API without a request body and returns the response body.
 // It is used for GET or DELETE requests where no request body is required.
 func callAPIWithoutBody(ctx context.Context, client *config.MongoDBClient, callParams config.APICallParams) APICallResult {
@@ -362,6 +463,7 @@ func callAPIWithoutBody(ctx context.Context, client *config.MongoDBClient, callP
 // callDelete makes a DELETE request to the API, supporting both requests with and without a body.
 // Returns nil if the resource is not found (already deleted).
 func callDelete(ctx context.Context, req *HandleDeleteReq) error {
+	defer lockOperation(req.OperationLock, req.CallParams)()
 	callResult := callDeleteWithHooks(ctx, req.Client, *req.CallParams, req, req.Hooks)
 	if notFound(callResult.Body, callResult.Resp) { // Resource is already deleted, don't fail.
 		return nil
@@ -435,13 +537,13 @@ func isEmptyJSON(raw []byte) bool {
 
 var emptyJSON = []byte("{}")
 
-func callCreateWithHooks(ctx context.Context, client *config.MongoDBClient, callParams config.APICallParams, bodyReq []byte, hooks any) APICallResult {
+func callCreateWithHooks(ctx context.Context, client *config.MongoDBClient, callParams config.APICallParams, bodyReq []byte, retryReq *RetryReq, hooks any) APICallResult {
 	var modifiedParams = callParams
 	var modifiedBody = bodyReq
 	if preCreateHook, ok := hooks.(PreCreateAPICallHook); ok {
 		modifiedParams, modifiedBody = preCreateHook.PreCreateAPICall(callParams, bodyReq)
 	}
-	callResult := callAPI(ctx, client, modifiedParams, modifiedBody)
+	callResult := callAPIWithRetry(ctx, client, modifiedParams, modifiedBody, retryReq)
 	if postCreateHook, ok := hooks.(PostCreateAPICallHook); ok {
 		return postCreateHook.PostCreateAPICall(callResult)
 	}
@@ -469,25 +571,24 @@ func callDeleteWithHooks(ctx context.Context, client *config.MongoDBClient, call
 	if preDeleteHook, ok := hooks.(PreDeleteAPICallHook); ok {
 		modifiedParams = preDeleteHook.PreDeleteAPICall(callParams)
 	}
-	var callResult APICallResult
-	if req.StaticRequestBody == "" {
-		callResult = callAPIWithoutBody(ctx, req.Client, modifiedParams)
-	} else {
-		callResult = callAPI(ctx, req.Client, modifiedParams, []byte(req.StaticRequestBody))
+	var bodyReq []byte
+	if req.StaticRequestBody != "" {
+		bodyReq = []byte(req.StaticRequestBody)
 	}
+	callResult := callAPIWithRetry(ctx, req.Client, modifiedParams, bodyReq, req.Retry)
 	if postDeleteHook, ok := hooks.(PostDeleteAPICallHook); ok {
 		return postDeleteHook.PostDeleteAPICall(callResult)
 	}
 	return callResult
 }
 
-func callUpdateWithHooks(ctx context.Context, client *config.MongoDBClient, callParams config.APICallParams, bodyReq []byte, hooks any) APICallResult {
+func callUpdateWithHooks(ctx context.Context, client *config.MongoDBClient, callParams config.APICallParams, bodyReq []byte, retryReq *RetryReq, hooks any) APICallResult {
 	var modifiedParams = callParams
 	var modifiedBody = bodyReq
 	if preUpdateHook, ok := hooks.(PreUpdateAPICallHook); ok {
 		modifiedParams, modifiedBody = preUpdateHook.PreUpdateAPICall(callParams, bodyReq)
 	}
-	callResult := callAPI(ctx, client, modifiedParams, modifiedBody)
+	callResult := callAPIWithRetry(ctx, client, modifiedParams, modifiedBody, retryReq)
 	if postUpdateHook, ok := hooks.(PostUpdateAPICallHook); ok {
 		return postUpdateHook.PostUpdateAPICall(callResult)
 	}
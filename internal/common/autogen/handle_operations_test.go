@@ -0,0 +1,236 @@
+package autogen_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/concurrency"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/retrystrategy"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+)
+
+type fakeResponse struct {
+	errorCode string
+	status    int
+}
+
+// fakeAtlasTransport answers with the responses in order, repeating the last one, and records when each request was received.
+// onRequest is called, if set, before answering each request.
+type fakeAtlasTransport struct {
+	onRequest func(req *http.Request)
+	calls     []time.Time
+	responses []fakeResponse
+	mu        sync.Mutex
+}
+
+func (f *fakeAtlasTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if f.onRequest != nil {
+		f.onRequest(req)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	resp := f.responses[min(len(f.calls), len(f.responses)-1)]
+	f.calls = append(f.calls, time.Now())
+	body := "{}"
+	if resp.status >= 300 {
+		body = fmt.Sprintf(`{"error": %d, "errorCode": %q, "detail": "fake error"}`, resp.status, resp.errorCode)
+	}
+	return &http.Response{
+		StatusCode: resp.status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func fakeClient(t *testing.T, transport http.RoundTripper) *config.MongoDBClient {
+	t.Helper()
+	atlasV2, err := admin.NewClient(admin.UseHTTPClient(&http.Client{Transport: transport}), admin.UseBaseURL("https://atlas.test"))
+	require.NoError(t, err)
+	return &config.MongoDBClient{AtlasV2: atlasV2}
+}
+
+func TestCallAPIWithRetry(t *testing.T) {
+	timeConfig := retrystrategy.TimeConfig{Timeout: time.Minute, MinTimeout: time.Millisecond}
+	var (
+		conflict    = fakeResponse{status: http.StatusConflict, errorCode: "CANNOT_UPDATE_TEST"}
+		serverError = fakeResponse{status: http.StatusInternalServerError, errorCode: "UNEXPECTED_ERROR"}
+		ok          = fakeResponse{status: http.StatusOK}
+	)
+	testCases := map[string]struct {
+		retry         *autogen.RetryReq
+		method        string
+		responses     []fakeResponse
+		expectedCalls int
+		expectedError bool
+	}{
+		"no retry policy": {
+			method:        http.MethodDelete,
+			responses:     []fakeResponse{serverError, ok},
+			expectedCalls: 1,
+			expectedError: true,
+		},
+		"status code retried until success": {
+			retry:         &autogen.RetryReq{StatusCodes: []int{http.StatusInternalServerError}, MaxAttempts: 5, TimeConfig: timeConfig},
+			method:        http.MethodDelete,
+			responses:     []fakeResponse{serverError, serverError, ok},
+			expectedCalls: 3,
+		},
+		"error code retried until success": {
+			retry:         &autogen.RetryReq{ErrorCodes: []string{"CANNOT_UPDATE_TEST"}, TimeConfig: timeConfig},
+			method:        http.MethodPut,
+			responses:     []fakeResponse{conflict, ok},
+			expectedCalls: 2,
+		},
+		"other error codes are not retried": {
+			retry:         &autogen.RetryReq{ErrorCodes: []string{"OTHER_ERROR"}, TimeConfig: timeConfig},
+			method:        http.MethodPut,
+			responses:     []fakeResponse{conflict, ok},
+			expectedCalls: 1,
+			expectedError: true,
+		},
+		"max attempts include the first call": {
+			retry:         &autogen.RetryReq{StatusCodes: []int{http.StatusInternalServerError}, MaxAttempts: 3, TimeConfig: timeConfig},
+			method:        http.MethodDelete,
+			responses:     []fakeResponse{serverError},
+			expectedCalls: 3,
+			expectedError: true,
+		},
+		"timeout stops retries before the backoff": {
+			retry:         &autogen.RetryReq{StatusCodes: []int{http.StatusInternalServerError}, TimeConfig: retrystrategy.TimeConfig{Timeout: time.Millisecond, MinTimeout: time.Second}},
+			method:        http.MethodDelete,
+			responses:     []fakeResponse{serverError, ok},
+			expectedCalls: 1,
+			expectedError: true,
+		},
+		"POST not retried by default": {
+			retry:         &autogen.RetryReq{StatusCodes: []int{http.StatusInternalServerError}, MaxAttempts: 5, TimeConfig: timeConfig},
+			method:        http.MethodPost,
+			responses:     []fakeResponse{serverError, ok},
+			expectedCalls: 1,
+			expectedError: true,
+		},
+		"PATCH not retried by default": {
+			retry:         &autogen.RetryReq{ErrorCodes: []string{"CANNOT_UPDATE_TEST"}, TimeConfig: timeConfig},
+			method:        http.MethodPatch,
+			responses:     []fakeResponse{conflict, ok},
+			expectedCalls: 1,
+			expectedError: true,
+		},
+		"POST retried when allowed": {
+			retry:         &autogen.RetryReq{StatusCodes: []int{http.StatusInternalServerError}, MaxAttempts: 5, AllowNonIdempotent: true, TimeConfig: timeConfig},
+			method:        http.MethodPost,
+			responses:     []fakeResponse{serverError, ok},
+			expectedCalls: 2,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			transport := &fakeAtlasTransport{responses: tc.responses}
+			callParams := config.APICallParams{
+				VersionHeader: "application/vnd.atlas.2023-01-01+json",
+				RelativePath:  "/api/atlas/v2/groups/{groupId}/test",
+				PathParams:    map[string]string{"groupId": "projectId"},
+				Method:        tc.method,
+			}
+			callResult := autogen.CallAPIWithRetryForTest(t.Context(), fakeClient(t, transport), callParams, []byte("{}"), tc.retry)
+			assert.Len(t, transport.calls, tc.expectedCalls)
+			assert.Equal(t, tc.expectedError, callResult.Err != nil)
+		})
+	}
+}
+
+func TestCallAPIWithRetryBackoff(t *testing.T) {
+	const minTimeout = 20 * time.Millisecond
+	transport := &fakeAtlasTransport{responses: []fakeResponse{{status: http.StatusServiceUnavailable}}}
+	retry := &autogen.RetryReq{
+		StatusCodes: []int{http.StatusServiceUnavailable},
+		MaxAttempts: 3,
+		TimeConfig:  retrystrategy.TimeConfig{Timeout: time.Minute, MinTimeout: minTimeout},
+	}
+	callParams := config.APICallParams{VersionHeader: "application/vnd.atlas.2023-01-01+json", RelativePath: "/api/atlas/v2/test", Method: http.MethodGet}
+	callResult := autogen.CallAPIWithRetryForTest(t.Context(), fakeClient(t, transport), callParams, nil, retry)
+	require.Error(t, callResult.Err)
+	require.Len(t, transport.calls, 3)
+	// The backoff starts at MinTimeout and is doubled in each retry.
+	assert.GreaterOrEqual(t, transport.calls[1].Sub(transport.calls[0]), minTimeout)
+	assert.GreaterOrEqual(t, transport.calls[2].Sub(transport.calls[1]), 2*minTimeout)
+}
+
+func TestHandleDeleteOperationLock(t *testing.T) {
+	const lockKey = "project:projectId:networking"
+	var (
+		methods            []string
+		lockFreeDuringWait atomic.Bool
+	)
+	transport := &fakeAtlasTransport{
+		responses: []fakeResponse{{status: http.StatusNoContent}, {status: http.StatusNotFound, errorCode: "RESOURCE_NOT_FOUND"}},
+		onRequest: func(req *http.Request) {
+			methods = append(methods, req.Method)
+			if req.Method != http.MethodGet {
+				return
+			}
+			// Other operations with the same key must be able to run while waiting for the delete to finish.
+			locked := make(chan struct{})
+			go func() {
+				concurrency.LockOperation(lockKey)()
+				close(locked)
+			}()
+			select {
+			case <-locked:
+				lockFreeDuringWait.Store(true)
+			case <-time.After(time.Second):
+			}
+		},
+	}
+	callParams := &config.APICallParams{
+		VersionHeader: "application/vnd.atlas.2023-01-01+json",
+		RelativePath:  "/api/atlas/v2/groups/{groupId}/test",
+		PathParams:    map[string]string{"groupId": "projectId"},
+		Method:        http.MethodDelete,
+	}
+	readParams := *callParams
+	readParams.Method = http.MethodGet
+	var diags diag.Diagnostics
+	req := autogen.HandleDeleteReq{
+		Client:        fakeClient(t, transport),
+		Diags:         &diags,
+		CallParams:    callParams,
+		OperationLock: "project:{groupId}:networking",
+		Wait: &autogen.WaitReq{
+			CallParams:    func(any) *config.APICallParams { return &readParams },
+			StateProperty: "status",
+			PendingStates: []string{"DELETING"},
+			TargetStates:  []string{"DELETED"},
+			Timeout:       time.Minute,
+		},
+	}
+
+	unlock := concurrency.LockOperation(lockKey)
+	done := make(chan struct{})
+	go func() {
+		autogen.HandleDelete(t.Context(), req)
+		close(done)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	transport.mu.Lock()
+	assert.Empty(t, transport.calls, "delete must wait for the operation lock")
+	transport.mu.Unlock()
+	unlock()
+	<-done
+
+	assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	assert.Equal(t, []string{http.MethodDelete, http.MethodGet}, methods)
+	assert.True(t, lockFreeDuringWait.Load(), "operation lock must be released while waiting")
+}
@@ -7,7 +7,6 @@ import (
 	"maps"
 	"reflect"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen/customtypes"
@@ -17,7 +16,7 @@ import (
 // Marshal gets a Terraform model and marshals it into JSON (e.g. for an Atlas request).
 // It supports the following types:
 //   - Terraform types: String, Bool, Int64, Float64.
-//   - Custom types: Object, Map, List, Set & jsontypes.Normalized.
+//   - Custom types: Object, Map, List, Set & customtypes.JSONValue.
 //
 // Attributes that are null or unknown are not marshaled by default.
 // This behavior can be controlled via autogen tags (tags are exclusive):
@@ -130,7 +129,7 @@ func getModelAttr(val attr.Value, isUpdate bool) (any, error) {
 		return v.ValueInt64(), nil
 	case types.Float64:
 		return v.ValueFloat64(), nil
-	case jsontypes.Normalized:
+	case customtypes.JSONValue:
 		var valueJSON any
 		if err := Decode([]byte(v.ValueString()), &valueJSON); err != nil {
 			return nil, fmt.Errorf("marshal failed for JSON custom type: %v", err)
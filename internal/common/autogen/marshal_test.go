@@ -4,7 +4,6 @@ import (
 	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen"
@@ -79,34 +78,34 @@ func TestMarshalWithApiNameTag(t *testing.T) {
 
 func TestMarshalDynamicJSONAttr(t *testing.T) {
 	model := struct {
-		AttrDynamicJSONObject         jsontypes.Normalized                        `tfsdk:"attr_dynamic_json_object"`
-		AttrDynamicJSONBoolean        jsontypes.Normalized                        `tfsdk:"attr_dynamic_json_boolean"`
-		AttrDynamicJSONString         jsontypes.Normalized                        `tfsdk:"attr_dynamic_json_string"`
-		AttrDynamicJSONNumber         jsontypes.Normalized                        `tfsdk:"attr_dynamic_json_number"`
-		AttrDynamicJSONArray          jsontypes.Normalized                        `tfsdk:"attr_dynamic_json_array"`
-		AttrListOfDynamicJSONObjects  customtypes.ListValue[jsontypes.Normalized] `tfsdk:"attr_list_of_dynamic_json_objects"`
-		AttrSetOfDynamicJSONObjects   customtypes.SetValue[jsontypes.Normalized]  `tfsdk:"attr_set_of_dynamic_json_objects"`
-		AttrMapOfDynamicJSONObjects   customtypes.MapValue[jsontypes.Normalized]  `tfsdk:"attr_map_of_dynamic_json_objects"`
-		AttrListOfDynamicJSONBooleans customtypes.ListValue[jsontypes.Normalized] `tfsdk:"attr_list_of_dynamic_json_booleans"`
-		AttrSetOfDynamicJSONBooleans  customtypes.SetValue[jsontypes.Normalized]  `tfsdk:"attr_set_of_dynamic_json_booleans"`
-		AttrMapOfDynamicJSONBooleans  customtypes.MapValue[jsontypes.Normalized]  `tfsdk:"attr_map_of_dynamic_json_booleans"`
+		AttrDynamicJSONObject         customtypes.JSONValue                        `tfsdk:"attr_dynamic_json_object"`
+		AttrDynamicJSONBoolean        customtypes.JSONValue                        `tfsdk:"attr_dynamic_json_boolean"`
+		AttrDynamicJSONString         customtypes.JSONValue                        `tfsdk:"attr_dynamic_json_string"`
+		AttrDynamicJSONNumber         customtypes.JSONValue                        `tfsdk:"attr_dynamic_json_number"`
+		AttrDynamicJSONArray          customtypes.JSONValue                        `tfsdk:"attr_dynamic_json_array"`
+		AttrListOfDynamicJSONObjects  customtypes.ListValue[customtypes.JSONValue] `tfsdk:"attr_list_of_dynamic_json_objects"`
+		AttrSetOfDynamicJSONObjects   customtypes.SetValue[customtypes.JSONValue]  `tfsdk:"attr_set_of_dynamic_json_objects"`
+		AttrMapOfDynamicJSONObjects   customtypes.MapValue[customtypes.JSONValue]  `tfsdk:"attr_map_of_dynamic_json_objects"`
+		AttrListOfDynamicJSONBooleans customtypes.ListValue[customtypes.JSONValue] `tfsdk:"attr_list_of_dynamic_json_booleans"`
+		AttrSetOfDynamicJSONBooleans  customtypes.SetValue[customtypes.JSONValue]  `tfsdk:"attr_set_of_dynamic_json_booleans"`
+		AttrMapOfDynamicJSONBooleans  customtypes.MapValue[customtypes.JSONValue]  `tfsdk:"attr_map_of_dynamic_json_booleans"`
 	}{
-		AttrDynamicJSONObject:        jsontypes.NewNormalizedValue("{\"hello\": \"there\"}"),
-		AttrDynamicJSONBoolean:       jsontypes.NewNormalizedValue("true"),
-		AttrDynamicJSONString:        jsontypes.NewNormalizedValue("\"hello\""),
-		AttrDynamicJSONNumber:        jsontypes.NewNormalizedValue("1.234"),
-		AttrDynamicJSONArray:         jsontypes.NewNormalizedValue("[1, 2, 3]"),
-		AttrListOfDynamicJSONObjects: customtypes.NewListValue[jsontypes.Normalized](t.Context(), []attr.Value{jsontypes.NewNormalizedValue("{\"hello\": \"there\"}")}),
-		AttrSetOfDynamicJSONObjects:  customtypes.NewSetValue[jsontypes.Normalized](t.Context(), []attr.Value{jsontypes.NewNormalizedValue("{\"hello\": \"there\"}")}),
-		AttrMapOfDynamicJSONObjects: customtypes.NewMapValue[jsontypes.Normalized](t.Context(), map[string]attr.Value{
-			"key1": jsontypes.NewNormalizedValue("{\"hello\": \"there\"}"),
-			"key2": jsontypes.NewNormalizedValue("{\"hello\": \"there\"}"),
+		AttrDynamicJSONObject:        customtypes.NewJSONValue("{\"hello\": \"there\"}"),
+		AttrDynamicJSONBoolean:       customtypes.NewJSONValue("true"),
+		AttrDynamicJSONString:        customtypes.NewJSONValue("\"hello\""),
+		AttrDynamicJSONNumber:        customtypes.NewJSONValue("1.234"),
+		AttrDynamicJSONArray:         customtypes.NewJSONValue("[1, 2, 3]"),
+		AttrListOfDynamicJSONObjects: customtypes.NewListValue[customtypes.JSONValue](t.Context(), []attr.Value{customtypes.NewJSONValue("{\"hello\": \"there\"}")}),
+		AttrSetOfDynamicJSONObjects:  customtypes.NewSetValue[customtypes.JSONValue](t.Context(), []attr.Value{customtypes.NewJSONValue("{\"hello\": \"there\"}")}),
+		AttrMapOfDynamicJSONObjects: customtypes.NewMapValue[customtypes.JSONValue](t.Context(), map[string]attr.Value{
+			"key1": customtypes.NewJSONValue("{\"hello\": \"there\"}"),
+			"key2": customtypes.NewJSONValue("{\"hello\": \"there\"}"),
 		}),
-		AttrListOfDynamicJSONBooleans: customtypes.NewListValue[jsontypes.Normalized](t.Context(), []attr.Value{jsontypes.NewNormalizedValue("true")}),
-		AttrSetOfDynamicJSONBooleans:  customtypes.NewSetValue[jsontypes.Normalized](t.Context(), []attr.Value{jsontypes.NewNormalizedValue("true")}),
-		AttrMapOfDynamicJSONBooleans: customtypes.NewMapValue[jsontypes.Normalized](t.Context(), map[string]attr.Value{
-			"key1": jsontypes.NewNormalizedValue("true"),
-			"key2": jsontypes.NewNormalizedValue("false"),
+		AttrListOfDynamicJSONBooleans: customtypes.NewListValue[customtypes.JSONValue](t.Context(), []attr.Value{customtypes.NewJSONValue("true")}),
+		AttrSetOfDynamicJSONBooleans:  customtypes.NewSetValue[customtypes.JSONValue](t.Context(), []attr.Value{customtypes.NewJSONValue("true")}),
+		AttrMapOfDynamicJSONBooleans: customtypes.NewMapValue[customtypes.JSONValue](t.Context(), map[string]attr.Value{
+			"key1": customtypes.NewJSONValue("true"),
+			"key2": customtypes.NewJSONValue("false"),
 		}),
 	}
 	const expectedJSON = `
@@ -850,12 +849,12 @@ func TestMarshalAnonymousNonStruct(t *testing.T) {
 }
 
 func TestMarshalDynamicJSONLargeInteger(t *testing.T) {
-	// Big integers inside jsontypes.Normalized attributes must be sent to the API unrounded.
+	// Big integers inside customtypes.JSONValue attributes must be sent to the API unrounded.
 	// assert.JSONEq cannot be used here as it decodes numbers to float64 and would hide precision loss.
 	model := struct {
-		AttrDynamicJSON jsontypes.Normalized `tfsdk:"attr_dynamic_json"`
+		AttrDynamicJSON customtypes.JSONValue `tfsdk:"attr_dynamic_json"`
 	}{
-		AttrDynamicJSON: jsontypes.NewNormalizedValue(`{"bigInt": 9007199254740993, "nested": {"list": [9007199254740993]}}`),
+		AttrDynamicJSON: customtypes.NewJSONValue(`{"bigInt": 9007199254740993, "nested": {"list": [9007199254740993]}}`),
 	}
 	raw, err := autogen.Marshal(&model, false)
 	require.NoError(t, err)
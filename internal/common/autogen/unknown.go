@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen/customtypes"
@@ -51,8 +50,8 @@ func prepareAttr(value attr.Value) (attr.Value, error) {
 			return types.Int64Null(), nil
 		case types.Float64:
 			return types.Float64Null(), nil
-		case jsontypes.Normalized:
-			return jsontypes.NewNormalizedNull(), nil
+		case customtypes.JSONValue:
+			return customtypes.NewJSONValueNull(), nil
 		case customtypes.ObjectValueInterface:
 			return v.NewObjectValueNull(ctx), nil
 		case customtypes.ListValueInterface:
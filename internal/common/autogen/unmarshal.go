@@ -9,7 +9,6 @@ import (
 	"reflect"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen/customtypes"
@@ -139,8 +138,8 @@ func setAttrTfModel(name string, field reflect.Value, val attr.Value) error {
 
 func getTfAttr(value any, valueType attr.Type, oldVal attr.Value, name string, skipListMerge bool) (attr.Value, error) {
 	nameErr := stringcase.ToSnakeCase(name)
-	if _, ok := valueType.(jsontypes.NormalizedType); ok {
-		return getNormalizedJSONAttrValue(value, nameErr)
+	if _, ok := valueType.(customtypes.JSONType); ok {
+		return getJSONAttrValue(value, nameErr)
 	}
 	switch v := value.(type) {
 	case string:
@@ -200,13 +199,13 @@ func getTfAttr(value any, valueType attr.Type, oldVal attr.Value, name string, s
 	return nil, fmt.Errorf("unmarshal not supported yet for type %T for attribute %s", value, nameErr)
 }
 
-func getNormalizedJSONAttrValue(value any, nameErr string) (attr.Value, error) {
-	// Marshal the value as a JSON string and return a jsontypes.NormalizedValue.
+func getJSONAttrValue(value any, nameErr string) (attr.Value, error) {
+	// Marshal the value as a JSON string and return a customtypes.JSONValue.
 	jsonBytes, err := json.Marshal(value)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal value to JSON for attribute %s", nameErr)
 	}
-	return jsontypes.NewNormalizedValue(string(jsonBytes)), nil
+	return customtypes.NewJSONValue(string(jsonBytes)), nil
 }
 
 func errUnmarshal(valueType attr.Type, typeReceived, name string) error {
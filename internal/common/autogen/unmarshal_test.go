@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen"
@@ -84,17 +83,17 @@ func TestUnmarshalDynamicJSONAttr(t *testing.T) {
 	ctx := context.Background()
 
 	type modelst struct {
-		AttrDynamicJSONObject         jsontypes.Normalized                        `tfsdk:"attr_dynamic_json_object"`
-		AttrDynamicJSONBoolean        jsontypes.Normalized                        `tfsdk:"attr_dynamic_json_boolean"`
-		AttrDynamicJSONNumber         jsontypes.Normalized                        `tfsdk:"attr_dynamic_json_number"`
-		AttrDynamicJSONString         jsontypes.Normalized                        `tfsdk:"attr_dynamic_json_string"`
-		AttrDynamicJSONArray          jsontypes.Normalized                        `tfsdk:"attr_dynamic_json_array"`
-		AttrListOfDynamicJSONObjects  customtypes.ListValue[jsontypes.Normalized] `tfsdk:"attr_list_of_dynamic_json_objects"`
-		AttrSetOfDynamicJSONObjects   customtypes.SetValue[jsontypes.Normalized]  `tfsdk:"attr_set_of_dynamic_json_objects"`
-		AttrMapOfDynamicJSONObjects   customtypes.MapValue[jsontypes.Normalized]  `tfsdk:"attr_map_of_dynamic_json_objects"`
-		AttrListOfDynamicJSONBooleans customtypes.ListValue[jsontypes.Normalized] `tfsdk:"attr_list_of_dynamic_json_booleans"`
-		AttrSetOfDynamicJSONBooleans  customtypes.SetValue[jsontypes.Normalized]  `tfsdk:"attr_set_of_dynamic_json_booleans"`
-		AttrMapOfDynamicJSONBooleans  customtypes.MapValue[jsontypes.Normalized]  `tfsdk:"attr_map_of_dynamic_json_booleans"`
+		AttrDynamicJSONObject         customtypes.JSONValue                        `tfsdk:"attr_dynamic_json_object"`
+		AttrDynamicJSONBoolean        customtypes.JSONValue                        `tfsdk:"attr_dynamic_json_boolean"`
+		AttrDynamicJSONNumber         customtypes.JSONValue                        `tfsdk:"attr_dynamic_json_number"`
+		AttrDynamicJSONString         customtypes.JSONValue                        `tfsdk:"attr_dynamic_json_string"`
+		AttrDynamicJSONArray          customtypes.JSONValue                        `tfsdk:"attr_dynamic_json_array"`
+		AttrListOfDynamicJSONObjects  customtypes.ListValue[customtypes.JSONValue] `tfsdk:"attr_list_of_dynamic_json_objects"`
+		AttrSetOfDynamicJSONObjects   customtypes.SetValue[customtypes.JSONValue]  `tfsdk:"attr_set_of_dynamic_json_objects"`
+		AttrMapOfDynamicJSONObjects   customtypes.MapValue[customtypes.JSONValue]  `tfsdk:"attr_map_of_dynamic_json_objects"`
+		AttrListOfDynamicJSONBooleans customtypes.ListValue[customtypes.JSONValue] `tfsdk:"attr_list_of_dynamic_json_booleans"`
+		AttrSetOfDynamicJSONBooleans  customtypes.SetValue[customtypes.JSONValue]  `tfsdk:"attr_set_of_dynamic_json_booleans"`
+		AttrMapOfDynamicJSONBooleans  customtypes.MapValue[customtypes.JSONValue]  `tfsdk:"attr_map_of_dynamic_json_booleans"`
 	}
 
 	var model modelst
@@ -114,30 +113,30 @@ func TestUnmarshalDynamicJSONAttr(t *testing.T) {
 		}
 	`
 	modelExpected := modelst{
-		AttrDynamicJSONObject:  jsontypes.NewNormalizedValue("{\"hello\":\"there\"}"),
-		AttrDynamicJSONBoolean: jsontypes.NewNormalizedValue("true"),
-		AttrDynamicJSONNumber:  jsontypes.NewNormalizedValue("1.234"),
-		AttrDynamicJSONString:  jsontypes.NewNormalizedValue("\"hello\""),
-		AttrDynamicJSONArray:   jsontypes.NewNormalizedValue("[1,2,3]"),
-		AttrListOfDynamicJSONObjects: customtypes.NewListValue[jsontypes.Normalized](ctx, []attr.Value{
-			jsontypes.NewNormalizedValue("{\"hello\":\"there\"}"),
+		AttrDynamicJSONObject:  customtypes.NewJSONValue("{\"hello\":\"there\"}"),
+		AttrDynamicJSONBoolean: customtypes.NewJSONValue("true"),
+		AttrDynamicJSONNumber:  customtypes.NewJSONValue("1.234"),
+		AttrDynamicJSONString:  customtypes.NewJSONValue("\"hello\""),
+		AttrDynamicJSONArray:   customtypes.NewJSONValue("[1,2,3]"),
+		AttrListOfDynamicJSONObjects: customtypes.NewListValue[customtypes.JSONValue](ctx, []attr.Value{
+			customtypes.NewJSONValue("{\"hello\":\"there\"}"),
 		}),
-		AttrSetOfDynamicJSONObjects: customtypes.NewSetValue[jsontypes.Normalized](ctx, []attr.Value{
-			jsontypes.NewNormalizedValue("{\"hello\":\"there\"}"),
+		AttrSetOfDynamicJSONObjects: customtypes.NewSetValue[customtypes.JSONValue](ctx, []attr.Value{
+			customtypes.NewJSONValue("{\"hello\":\"there\"}"),
 		}),
-		AttrMapOfDynamicJSONObjects: customtypes.NewMapValue[jsontypes.Normalized](ctx, map[string]attr.Value{
-			"key1": jsontypes.NewNormalizedValue("{\"hello\":\"there\"}"),
-			"key2": jsontypes.NewNormalizedValue("{\"hello\":\"there\"}"),
+		AttrMapOfDynamicJSONObjects: customtypes.NewMapValue[customtypes.JSONValue](ctx, map[string]attr.Value{
+			"key1": customtypes.NewJSONValue("{\"hello\":\"there\"}"),
+			"key2": customtypes.NewJSONValue("{\"hello\":\"there\"}"),
 		}),
-		AttrListOfDynamicJSONBooleans: customtypes.NewListValue[jsontypes.Normalized](ctx, []attr.Value{
-			jsontypes.NewNormalizedValue("true"),
+		AttrListOfDynamicJSONBooleans: customtypes.NewListValue[customtypes.JSONValue](ctx, []attr.Value{
+			customtypes.NewJSONValue("true"),
 		}),
-		AttrSetOfDynamicJSONBooleans: customtypes.NewSetValue[jsontypes.Normalized](ctx, []attr.Value{
-			jsontypes.NewNormalizedValue("true"),
+		AttrSetOfDynamicJSONBooleans: customtypes.NewSetValue[customtypes.JSONValue](ctx, []attr.Value{
+			customtypes.NewJSONValue("true"),
 		}),
-		AttrMapOfDynamicJSONBooleans: customtypes.NewMapValue[jsontypes.Normalized](ctx, map[string]attr.Value{
-			"key1": jsontypes.NewNormalizedValue("true"),
-			"key2": jsontypes.NewNormalizedValue("false"),
+		AttrMapOfDynamicJSONBooleans: customtypes.NewMapValue[customtypes.JSONValue](ctx, map[string]attr.Value{
+			"key1": customtypes.NewJSONValue("true"),
+			"key2": customtypes.NewJSONValue("false"),
 		}),
 	}
 
@@ -965,7 +964,7 @@ func TestUnmarshalLargeIntegers(t *testing.T) {
 		AttrListInt      customtypes.ListValue[types.Int64] `tfsdk:"attr_list_int"`
 		AttrSetInt       customtypes.SetValue[types.Int64]  `tfsdk:"attr_set_int"`
 		AttrMapInt       customtypes.MapValue[types.Int64]  `tfsdk:"attr_map_int"`
-		AttrDynamicJSON  jsontypes.Normalized               `tfsdk:"attr_dynamic_json"`
+		AttrDynamicJSON  customtypes.JSONValue              `tfsdk:"attr_dynamic_json"`
 		AttrIntBig       types.Int64                        `tfsdk:"attr_int_big"`
 		AttrIntMax       types.Int64                        `tfsdk:"attr_int_max"`
 		AttrIntWithFloat types.Int64                        `tfsdk:"attr_int_with_float"`
@@ -990,7 +989,7 @@ func TestUnmarshalLargeIntegers(t *testing.T) {
 		AttrIntMax:       types.Int64Value(9223372036854775807), // math.MaxInt64
 		AttrIntWithFloat: types.Int64Value(10),                  // response floats stored in model ints have their decimals stripped
 		AttrFloat:        types.Float64Value(1.234),
-		AttrDynamicJSON:  jsontypes.NewNormalizedValue(`{"bigInt":9007199254740993,"nested":{"list":[9007199254740993]}}`),
+		AttrDynamicJSON:  customtypes.NewJSONValue(`{"bigInt":9007199254740993,"nested":{"list":[9007199254740993]}}`),
 		AttrListInt: customtypes.NewListValue[types.Int64](ctx, []attr.Value{
 			types.Int64Value(9007199254740993),
 		}),
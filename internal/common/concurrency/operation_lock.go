@@ -0,0 +1,42 @@
+package concurrency
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scopes of project operations that Atlas doesn't allow to run concurrently.
+const (
+	ScopeNetworking       = "networking"
+	ScopeEncryptionAtRest = "encryption_at_rest"
+	ScopeCustomDBRoles    = "custom_db_roles"
+	ScopeAccessList       = "access_list"
+)
+
+// operationLocks is shared by all resources in the provider so resources of different types can serialize their operations.
+var operationLocks = NewMutexKV()
+
+// ProjectOperationKey returns the operation lock key for a scope in a project, e.g. "project:{id}:networking".
+func ProjectOperationKey(projectID, scope string) string {
+	return fmt.Sprintf("project:%s:%s", projectID, scope)
+}
+
+// LockOperation waits until no other operation with the same key is running in the provider and locks the key.
+// The returned function unlocks the key, it's typically deferred. An empty key doesn't lock anything.
+func LockOperation(key string) (unlock func()) {
+	if key == "" {
+		return func() {}
+	}
+	operationLocks.Lock(key)
+	return func() { operationLocks.Unlock(key) }
+}
+
+// ExpandOperationKey replaces the {param} placeholders in a key template with their values,
+// e.g. "project:{groupId}:networking" with groupId path param.
+func ExpandOperationKey(keyTemplate string, params map[string]string) string {
+	key := keyTemplate
+	for name, value := range params {
+		key = strings.ReplaceAll(key, "{"+name+"}", value)
+	}
+	return key
+}
@@ -0,0 +1,48 @@
+package concurrency_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/concurrency"
+)
+
+func TestExpandOperationKey(t *testing.T) {
+	key := concurrency.ExpandOperationKey("project:{groupId}:networking", map[string]string{"groupId": "p1", "peerId": "peer1"})
+	assert.Equal(t, concurrency.ProjectOperationKey("p1", concurrency.ScopeNetworking), key)
+	assert.Equal(t, "project:p1:networking", key)
+}
+
+func TestLockOperation(t *testing.T) {
+	const workers = 20
+	var (
+		wg      sync.WaitGroup
+		running int
+		maxRun  int
+		mu      sync.Mutex
+	)
+	key := concurrency.ProjectOperationKey("p1", concurrency.ScopeNetworking)
+	for range workers {
+		wg.Go(func() {
+			defer concurrency.LockOperation(key)()
+			mu.Lock()
+			running++
+			maxRun = max(maxRun, running)
+			mu.Unlock()
+			time.Sleep(time.Millisecond)
+			mu.Lock()
+			running--
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+	assert.Equal(t, 1, maxRun, "operations with the same key must not run concurrently")
+
+	unlockOther := concurrency.LockOperation(concurrency.ProjectOperationKey("p2", concurrency.ScopeNetworking))
+	defer unlockOther()
+	concurrency.LockOperation(key)() // different keys don't block each other
+	concurrency.LockOperation("")()  // empty key doesn't lock
+}
@@ -0,0 +1,26 @@
+package conversion
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// IdentitySchemaFromResource returns a resource identity schema with a required string attribute for each of the identity attribute names.
+// Descriptions are copied from the resource schema root attributes with the same name if they exist.
+func IdentitySchemaFromResource(rs schema.Schema, attrNames ...string) identityschema.Schema {
+	attrs := make(map[string]identityschema.Attribute, len(attrNames))
+	for _, name := range attrNames {
+		var description string
+		if rsAttr, ok := rs.Attributes[name]; ok {
+			description = rsAttr.GetMarkdownDescription()
+			if description == "" {
+				description = rsAttr.GetDescription()
+			}
+		}
+		attrs[name] = identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       description,
+		}
+	}
+	return identityschema.Schema{Attributes: attrs}
+}
@@ -0,0 +1,44 @@
+package conversion_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentitySchemaFromResource(t *testing.T) {
+	rs := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "project id",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "name",
+			},
+			"other": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+	expected := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"project_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "project id",
+			},
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "name",
+			},
+			"entry": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+	assert.Equal(t, expected, conversion.IdentitySchemaFromResource(rs, "project_id", "name", "entry"))
+}
@@ -0,0 +1,119 @@
+package policycheck
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	sdkv2diag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	warningSummary      = "Resource policy may apply"
+	warningNotChecked   = "Resource policies not checked"
+	errorFetchingFormat = "error fetching the resource policies of the organization of project %s: %w"
+	warningDetailFormat = "The resource policy %q (%s) of the organization restricts the %s action. Atlas evaluates it when the change is applied and rejects the change if the policy forbids it."
+)
+
+// policyCache keeps the resource policies of each organization and the organization of each project for the lifetime of the provider,
+// so they are fetched once per Terraform command. mu only protects the maps, requests are deduplicated by group.
+var policyCache = struct {
+	projectOrgs map[string]string
+	orgPolicies map[string][]ResourcePolicy
+	group       singleflight.Group
+	mu          sync.Mutex
+}{
+	projectOrgs: map[string]string{},
+	orgPolicies: map[string][]ResourcePolicy{},
+}
+
+// Check returns a warning pointing at attributePath for each resource policy of the organization of the project that may restrict action.
+// The provider doesn't evaluate the Cedar policies, Atlas enforces them when the change is applied.
+// If the policies can't be fetched a warning is returned instead.
+func Check(ctx context.Context, connV2 *admin.APIClient, projectID, action string, attributePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	policies, err := projectResourcePolicies(ctx, connV2, projectID)
+	if err != nil {
+		diags.AddWarning(warningNotChecked, err.Error())
+		return diags
+	}
+	for i := range policies {
+		if policies[i].AppliesTo(action) {
+			diags.AddAttributeWarning(attributePath, warningSummary, fmt.Sprintf(warningDetailFormat, policies[i].Name, policies[i].ID, action))
+		}
+	}
+	return diags
+}
+
+// WarningsSDKv2 is Check for SDKv2 resources. Warnings can't be returned from CustomizeDiff, so it's called from Create and Update.
+func WarningsSDKv2(ctx context.Context, meta any, projectID, action string) sdkv2diag.Diagnostics {
+	client, ok := meta.(*config.MongoDBClient)
+	if !ok || !client.CheckResourcePolicies {
+		return nil
+	}
+	return conversion.FromTPFDiagsToSDKV2Diags(Check(ctx, client.AtlasV2, projectID, action, path.Empty()))
+}
+
+func projectResourcePolicies(ctx context.Context, connV2 *admin.APIClient, projectID string) ([]ResourcePolicy, error) {
+	orgID, err := cached(policyCache.projectOrgs, "project", projectID, func() (string, error) {
+		project, _, err := connV2.ProjectsAPI.GetGroup(ctx, projectID).Execute()
+		return project.GetOrgId(), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errorFetchingFormat, projectID, err)
+	}
+	policies, err := cached(policyCache.orgPolicies, "org", orgID, func() ([]ResourcePolicy, error) {
+		apiResp, _, err := connV2.ResourcePoliciesAPI.ListOrgResourcePolicies(ctx, orgID).Execute()
+		if err != nil {
+			return nil, err
+		}
+		policies := make([]ResourcePolicy, 0, len(apiResp))
+		for i := range apiResp {
+			policies = append(policies, newResourcePolicy(&apiResp[i]))
+		}
+		return policies, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errorFetchingFormat, projectID, err)
+	}
+	return policies, nil
+}
+
+// cached returns the value of key in m, calling fetch if it's not cached yet. Concurrent calls for the same key share the same fetch,
+// and the cache lock is not held while fetching so calls for other keys are not blocked.
+func cached[T any](m map[string]T, kind, key string, fetch func() (T, error)) (T, error) {
+	get := func() (T, bool) {
+		policyCache.mu.Lock()
+		defer policyCache.mu.Unlock()
+		value, ok := m[key]
+		return value, ok
+	}
+	if value, ok := get(); ok {
+		return value, nil
+	}
+	value, err, _ := policyCache.group.Do(kind+"/"+key, func() (any, error) {
+		if value, ok := get(); ok { // Fetched by a call that finished after the first get.
+			return value, nil
+		}
+		value, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		policyCache.mu.Lock()
+		defer policyCache.mu.Unlock()
+		m[key] = value
+		return value, nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return value.(T), nil
+}
@@ -0,0 +1,122 @@
+package policycheck_test
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/atlas-sdk/v20250312023/mockadmin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/policycheck"
+)
+
+func TestCheck(t *testing.T) {
+	policycheck.ResetCacheForTest()
+	const (
+		checkProjectID = "664619d870c247237f4b86a7"
+		orgID          = "664619d870c247237f4b86a8"
+	)
+	var apiPolicies []admin.ApiAtlasResourcePolicy
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{
+			"id": "ipPolicyId",
+			"name": "no-open-access",
+			"policies": [{"id": "policyId1", "body": "forbid (principal, action == cloud::action::\"project.ipAccessList.modify\", resource) when { context.project.ipAccessList.contains(ip(\"0.0.0.0/0\")) };"}]
+		},
+		{
+			"id": "legacyPolicyId",
+			"name": "no-project-edit",
+			"policies": [{"id": "policyId2", "body": "forbid (principal, action == ResourcePolicy::Action::\"project.edit\", resource);"}]
+		},
+		{
+			"id": "allPolicyId",
+			"name": "no-changes",
+			"policies": [
+				{"id": "policyId3", "body": "forbid (principal, action == cloud::action::\"cluster.modify\", resource);"},
+				{"id": "policyId4", "body": "forbid (principal, action, resource);"}
+			]
+		}
+	]`), &apiPolicies))
+
+	projectsAPI := mockadmin.NewProjectsAPI(t)
+	projectsAPI.EXPECT().GetGroup(mock.Anything, checkProjectID).Return(admin.GetGroupApiRequest{ApiService: projectsAPI}).Once()
+	projectsAPI.EXPECT().GetGroupExecute(mock.Anything).Return(&admin.Group{OrgId: orgID}, nil, nil).Once()
+	policiesAPI := mockadmin.NewResourcePoliciesAPI(t)
+	policiesAPI.EXPECT().ListOrgResourcePolicies(mock.Anything, orgID).Return(admin.ListOrgResourcePoliciesApiRequest{ApiService: policiesAPI}).Once()
+	policiesAPI.EXPECT().ListOrgResourcePoliciesExecute(mock.Anything).Return(apiPolicies, nil, nil).Once()
+	connV2 := &admin.APIClient{ProjectsAPI: projectsAPI, ResourcePoliciesAPI: policiesAPI}
+
+	testCases := map[string]struct {
+		action   string
+		policies []string
+	}{
+		"action and legacy action": {
+			action:   policycheck.ActionIPAccessListModify,
+			policies: []string{"no-open-access", "no-project-edit", "no-changes"},
+		},
+		"legacy action": {
+			action:   policycheck.ActionPrivateNetworkingModify,
+			policies: []string{"no-project-edit", "no-changes"},
+		},
+		"policy without action applies to all actions": {
+			action:   policycheck.ActionClusterModify,
+			policies: []string{"no-changes"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			attributePath := path.Root("replication_specs")
+			diags := policycheck.Check(t.Context(), connV2, checkProjectID, tc.action, attributePath)
+			assert.False(t, diags.HasError(), "policies are only enforced by Atlas")
+			require.Len(t, diags.Warnings(), len(tc.policies))
+			for i, name := range tc.policies {
+				assert.Equal(t, "Resource policy may apply", diags.Warnings()[i].Summary())
+				assert.Contains(t, diags.Warnings()[i].Detail(), `"`+name+`"`)
+			}
+		})
+	}
+}
+
+func TestCheckFetchError(t *testing.T) {
+	policycheck.ResetCacheForTest()
+	const checkProjectID = "664619d870c247237f4b86a9"
+	projectsAPI := mockadmin.NewProjectsAPI(t)
+	projectsAPI.EXPECT().GetGroup(mock.Anything, checkProjectID).Return(admin.GetGroupApiRequest{ApiService: projectsAPI}).Once()
+	projectsAPI.EXPECT().GetGroupExecute(mock.Anything).Return(nil, nil, errors.New("forbidden")).Once()
+	connV2 := &admin.APIClient{ProjectsAPI: projectsAPI}
+
+	diags := policycheck.Check(t.Context(), connV2, checkProjectID, policycheck.ActionClusterModify, path.Root("replication_specs"))
+	assert.False(t, diags.HasError())
+	require.Len(t, diags.Warnings(), 1)
+	assert.Equal(t, "Resource policies not checked", diags.Warnings()[0].Summary())
+}
+
+func TestCheckConcurrent(t *testing.T) {
+	policycheck.ResetCacheForTest()
+	const (
+		checkProjectID = "664619d870c247237f4b86aa"
+		orgID          = "664619d870c247237f4b86ab"
+	)
+	projectsAPI := mockadmin.NewProjectsAPI(t)
+	projectsAPI.EXPECT().GetGroup(mock.Anything, checkProjectID).Return(admin.GetGroupApiRequest{ApiService: projectsAPI}).Once()
+	projectsAPI.EXPECT().GetGroupExecute(mock.Anything).Return(&admin.Group{OrgId: orgID}, nil, nil).Once()
+	policiesAPI := mockadmin.NewResourcePoliciesAPI(t)
+	policiesAPI.EXPECT().ListOrgResourcePolicies(mock.Anything, orgID).Return(admin.ListOrgResourcePoliciesApiRequest{ApiService: policiesAPI}).Once()
+	policiesAPI.EXPECT().ListOrgResourcePoliciesExecute(mock.Anything).Return([]admin.ApiAtlasResourcePolicy{{Id: admin.PtrString("id"), Name: admin.PtrString("name")}}, nil, nil).Once()
+	connV2 := &admin.APIClient{ProjectsAPI: projectsAPI, ResourcePoliciesAPI: policiesAPI}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			diags := policycheck.Check(t.Context(), connV2, checkProjectID, policycheck.ActionClusterModify, path.Root("replication_specs"))
+			assert.Len(t, diags.Warnings(), 1, "the policies are fetched once and shared by concurrent checks")
+		})
+	}
+	wg.Wait()
+}
@@ -0,0 +1,9 @@
+package policycheck
+
+// ResetCacheForTest empties the cache so each test fetches the resource policies.
+func ResetCacheForTest() {
+	policyCache.mu.Lock()
+	defer policyCache.mu.Unlock()
+	clear(policyCache.projectOrgs)
+	clear(policyCache.orgPolicies)
+}
@@ -0,0 +1,66 @@
+package policycheck
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+)
+
+// Actions of the Atlas operations checked by the provider.
+const (
+	ActionClusterModify           = "cluster.modify"
+	ActionIPAccessListModify      = "project.ipAccessList.modify"
+	ActionPrivateNetworkingModify = "project.privateNetworking.modify"
+)
+
+// legacyActions are action names accepted by Atlas in older policies, with the prefix of the actions they apply to.
+var legacyActions = map[string]string{
+	"cluster.createedit": ActionClusterModify,
+	"project.edit":       "project.",
+}
+
+// actionRegex finds the actions referenced by a Cedar policy, e.g. cloud::action::"cluster.modify" or ResourcePolicy::Action::"project.edit".
+var actionRegex = regexp.MustCompile(`(?i)action::"([^"]+)"`)
+
+// ResourcePolicy is an Atlas resource policy with the actions it restricts. The provider doesn't evaluate the Cedar policies,
+// Atlas enforces them when a change is applied.
+type ResourcePolicy struct {
+	ID      string
+	Name    string
+	Actions []string // Empty if a policy doesn't reference any action, so it may restrict all of them.
+}
+
+// newResourcePolicy returns the resource policy with the actions referenced by its Cedar policies.
+func newResourcePolicy(apiPolicy *admin.ApiAtlasResourcePolicy) ResourcePolicy {
+	policy := ResourcePolicy{ID: apiPolicy.GetId(), Name: apiPolicy.GetName()}
+	for _, item := range apiPolicy.GetPolicies() {
+		matches := actionRegex.FindAllStringSubmatch(item.GetBody(), -1)
+		if len(matches) == 0 {
+			return ResourcePolicy{ID: policy.ID, Name: policy.Name}
+		}
+		for _, match := range matches {
+			if !slices.Contains(policy.Actions, match[1]) {
+				policy.Actions = append(policy.Actions, match[1])
+			}
+		}
+	}
+	return policy
+}
+
+// AppliesTo returns true if the resource policy may restrict action.
+func (p *ResourcePolicy) AppliesTo(action string) bool {
+	return len(p.Actions) == 0 || slices.ContainsFunc(p.Actions, func(policyAction string) bool {
+		return actionMatches(policyAction, action)
+	})
+}
+
+func actionMatches(policyAction, action string) bool {
+	policyAction = strings.ToLower(policyAction)
+	action = strings.ToLower(action)
+	if prefix, ok := legacyActions[policyAction]; ok {
+		return strings.HasPrefix(action, strings.ToLower(prefix))
+	}
+	return policyAction == action
+}
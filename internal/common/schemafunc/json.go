@@ -2,13 +2,23 @@ package schemafunc
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"log"
+	"math/big"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// EqualJSON returns true if old and newStr are semantically equal JSON documents: key order and whitespace are ignored,
+// numbers are compared by value (1, 1.0 and 1e0 are equal) and MongoDB Extended JSON values are compared by the value they represent,
+// e.g. {"$numberLong": "1"} and 1, or {"$date": "2024-01-01T00:00:00Z"} and {"$date": {"$numberLong": "1704067200000"}}.
+// Empty strings are considered empty objects.
 func EqualJSON(old, newStr, errContext string) bool {
-	var j, j2 any
-
 	if old == "" {
 		old = "{}"
 	}
@@ -16,13 +26,135 @@ func EqualJSON(old, newStr, errContext string) bool {
 	if newStr == "" {
 		newStr = "{}"
 	}
-	if err := json.Unmarshal([]byte(old), &j); err != nil {
+	j, err := NormalizeJSON(old)
+	if err != nil {
 		log.Printf("[ERROR] cannot unmarshal old %s json %v", errContext, err)
 		return false
 	}
-	if err := json.Unmarshal([]byte(newStr), &j2); err != nil {
+	j2, err := NormalizeJSON(newStr)
+	if err != nil {
 		log.Printf("[ERROR] cannot unmarshal new %s json %v", errContext, err)
 		return false
 	}
-	return reflect.DeepEqual(&j, &j2)
+	return reflect.DeepEqual(j, j2)
+}
+
+// DiffSuppressJSON is a SDKv2 DiffSuppressFunc for JSON string attributes that ignores changes that are not semantic, see EqualJSON.
+func DiffSuppressJSON(k, old, newStr string, d *schema.ResourceData) bool {
+	return EqualJSON(old, newStr, k)
+}
+
+// CanonicalJSON returns a canonical representation of a JSON string, equal for all the JSON strings that are equal in EqualJSON.
+// It can be used to hash JSON attributes in sets. Invalid JSON strings are returned unchanged.
+func CanonicalJSON(s string) string {
+	if s == "" {
+		return ""
+	}
+	v, err := NormalizeJSON(s)
+	if err != nil {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return s
+	}
+	return string(b)
+}
+
+// NormalizeJSON decodes a JSON string into a value that can be compared with reflect.DeepEqual, see EqualJSON.
+func NormalizeJSON(s string) (any, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after the JSON value")
+	}
+	return normalizeJSONValue(v), nil
+}
+
+// jsonNumber is a number in canonical form, so numbers with the same value are equal.
+type jsonNumber string
+
+func (n jsonNumber) MarshalJSON() ([]byte, error) {
+	return []byte(n), nil
+}
+
+func newJSONNumber(s string) (jsonNumber, bool) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return "", false
+	}
+	if r.IsInt() {
+		return jsonNumber(r.Num().String()), true
+	}
+	f, _ := r.Float64()
+	return jsonNumber(strconv.FormatFloat(f, 'g', -1, 64)), true
+}
+
+func normalizeJSONValue(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if n, ok := newJSONNumber(v.String()); ok {
+			return n
+		}
+		return v.String()
+	case []any:
+		for i := range v {
+			v[i] = normalizeJSONValue(v[i])
+		}
+		return v
+	case map[string]any:
+		for key, value := range v {
+			v[key] = normalizeJSONValue(value)
+		}
+		if len(v) == 1 {
+			return normalizeExtendedJSON(v)
+		}
+		return v
+	}
+	return v
+}
+
+// normalizeExtendedJSON returns the value of a MongoDB Extended JSON object in the same form for its canonical and relaxed representations.
+// Objects that are not Extended JSON values are returned unchanged.
+func normalizeExtendedJSON(obj map[string]any) any {
+	for key, value := range obj {
+		switch key {
+		case "$numberInt", "$numberLong", "$numberDouble", "$numberDecimal":
+			if s, ok := value.(string); ok {
+				if n, ok := newJSONNumber(s); ok {
+					return n
+				}
+			}
+		case "$oid":
+			if s, ok := value.(string); ok {
+				return map[string]any{key: strings.ToLower(s)}
+			}
+		case "$date":
+			if millis, ok := extendedJSONDateMillis(value); ok {
+				return map[string]any{key: jsonNumber(strconv.FormatInt(millis, 10))}
+			}
+		}
+	}
+	return obj
+}
+
+// extendedJSONDateMillis returns the milliseconds since the Unix epoch of the value of a $date, which is an ISO-8601 string in relaxed
+// Extended JSON and a $numberLong in canonical Extended JSON. The $numberLong is already normalized to a number.
+func extendedJSONDateMillis(value any) (int64, bool) {
+	switch value := value.(type) {
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return 0, false
+		}
+		return t.UnixMilli(), true
+	case jsonNumber:
+		millis, err := strconv.ParseInt(string(value), 10, 64)
+		return millis, err == nil
+	}
+	return 0, false
 }
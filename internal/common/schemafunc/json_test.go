@@ -18,6 +18,16 @@ func Test_EqualJSON(t *testing.T) {
 		"double invalid object":              {`{{"a": 1}`, `{"b": 2}}`, false},
 		"equal objects with different order": {`{"a": 1, "b": 2}`, `{"b": 2, "a": 1}`, true},
 		"equal objects whitespace":           {`{"a": 1, "b": 2}`, `{"a":1,"b":2}`, true},
+		"trailing data":                      {`{"a": 1}`, `{"a": 1}}`, false},
+		"equal numbers":                      {`{"a": [1, 2.50, 1e3]}`, `{"a": [1.0, 2.5, 1000]}`, true},
+		"different numbers":                  {`{"a": 1}`, `{"a": 1.0000001}`, false},
+		"big integers":                       {`{"a": 9007199254740993}`, `{"a": 9007199254740992}`, false},
+		"extended json numbers":              {`{"a": {"$numberLong": "5"}, "b": {"$numberDouble": "2.5"}}`, `{"a": 5, "b": 2.5}`, true},
+		"extended json object ids":           {`{"_id": {"$oid": "5F1B2C3D4E5F6A7B8C9D0E1F"}}`, `{"_id": {"$oid": "5f1b2c3d4e5f6a7b8c9d0e1f"}}`, true},
+		"extended json dates":                {`{"d": {"$date": "2024-01-01T00:00:00Z"}}`, `{"d": {"$date": {"$numberLong": "1704067200000"}}}`, true},
+		"extended json dates with offset":    {`{"d": {"$date": "2024-01-01T01:00:00.000+01:00"}}`, `{"d": {"$date": 1704067200000}}`, true},
+		"different extended json dates":      {`{"d": {"$date": "2024-01-01T00:00:00Z"}}`, `{"d": {"$date": "2024-01-02T00:00:00Z"}}`, false},
+		"stage names are not extended json":  {`[{"$match": {"a": 1}}]`, `[{"$match": {"a": 1.0}}]`, true},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -28,3 +38,20 @@ func Test_EqualJSON(t *testing.T) {
 		})
 	}
 }
+
+func Test_CanonicalJSON(t *testing.T) {
+	testCases := map[string]string{
+		`{"b": 2.0, "a": {"$numberInt": "1"}}`:            `{"a":1,"b":2}`,
+		`{"d": {"$date": "2024-01-01T00:00:00Z"}}`:        `{"d":{"$date":1704067200000}}`,
+		`[{"$oid": "ABC"}, 9007199254740993, 0.1, -1e-7]`: `[{"$oid":"abc"},9007199254740993,0.1,-1e-07]`,
+		"invalid": "invalid",
+		"":        "",
+	}
+	for input, expected := range testCases {
+		t.Run(input, func(t *testing.T) {
+			if actual := schemafunc.CanonicalJSON(input); actual != expected {
+				t.Errorf("Expected: %v, got: %v", expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+)
+
+type ImplementedAction interface {
+	action.ActionWithConfigure
+	GetName() string
+	SetClient(*MongoDBClient)
+}
+
+func AnalyticsActionFunc(iAction action.Action) func() action.Action {
+	commonAction, ok := iAction.(ImplementedAction)
+	if !ok {
+		panic(fmt.Sprintf("action %T didn't comply with the ImplementedAction interface", iAction))
+	}
+	return func() action.Action {
+		return &ActionCommon{
+			ActionName:        commonAction.GetName(),
+			ImplementedAction: commonAction,
+		}
+	}
+}
+
+// ActionCommon is used as an embedded struct for all framework actions. Implements the following plugin-framework defined functions:
+// - Metadata
+// - Configure
+// Client is left empty and populated by the framework when envoking Configure method.
+// ActionName must be defined when creating an instance of an action.
+//
+// When used as a wrapper (ImplementedAction is set), it intercepts Invoke to add analytics tracking.
+type ActionCommon struct {
+	ImplementedAction // Set when used as a wrapper, nil when embedded
+	Client            *MongoDBClient
+	ActionName        string
+}
+
+func (a *ActionCommon) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, a.ActionName)
+}
+
+func (a *ActionCommon) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	if a.ImplementedAction != nil {
+		a.ImplementedAction.Schema(ctx, req, resp)
+	}
+}
+
+func (a *ActionCommon) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	client, err := configureClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(errorConfigureSummary, err.Error())
+		return
+	}
+	a.Client = client
+	if a.ImplementedAction != nil {
+		a.ImplementedAction.SetClient(client)
+	}
+}
+
+func (a *ActionCommon) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if a.ImplementedAction == nil {
+		return
+	}
+	ctx = AddUserAgentExtra(ctx, UserAgentExtra{
+		Name:      userAgentNameValue(a.ActionName),
+		Operation: UserAgentOperationValueInvoke,
+	})
+	a.ImplementedAction.Invoke(ctx, req, resp)
+}
+
+func (a *ActionCommon) GetName() string {
+	return a.ActionName
+}
+
+func (a *ActionCommon) SetClient(client *MongoDBClient) {
+	a.Client = client
+}
+
+// SendProgress reports a progress message to Terraform while the action is running.
+func SendProgress(resp *action.InvokeResponse, format string, args ...any) {
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf(format, args...)})
+	}
+}
@@ -0,0 +1,45 @@
+package config_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+type mockAction struct {
+	config.ActionCommon
+	invoked bool
+}
+
+func (a *mockAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{}
+}
+
+func (a *mockAction) Invoke(_ context.Context, _ action.InvokeRequest, resp *action.InvokeResponse) {
+	a.invoked = true
+	config.SendProgress(resp, "invoked %s", a.ActionName)
+}
+
+func TestAnalyticsAction(t *testing.T) {
+	mock := &mockAction{ActionCommon: config.ActionCommon{ActionName: "test_action"}}
+	wrapped := config.AnalyticsActionFunc(mock)()
+
+	metadataResp := action.MetadataResponse{}
+	wrapped.Metadata(t.Context(), action.MetadataRequest{ProviderTypeName: "mongodbatlas"}, &metadataResp)
+	assert.Equal(t, "mongodbatlas_test_action", metadataResp.TypeName)
+
+	var progress []string
+	invokeResp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+	wrapped.Invoke(t.Context(), action.InvokeRequest{}, &invokeResp)
+	assert.True(t, mock.invoked)
+	assert.Equal(t, []string{"invoked test_action"}, progress)
+}
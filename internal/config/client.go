@@ -105,14 +105,16 @@ func tfLoggingInterceptor(base http.RoundTripper) http.RoundTripper {
 
 // MongoDBClient contains the mongodbatlas clients and configurations.
 type MongoDBClient struct {
-	Atlas            *matlasClient.Client
-	AtlasV2          *admin.APIClient
-	AtlasPreview     *adminpreview.APIClient
-	AtlasV220240530  *admin20240530.APIClient // Used in cluster to support deprecated attributes default_read_concern and fail_index_key_too_long in advanced_configuration.
-	AtlasV220241113  *admin20241113.APIClient // Used in teams and atlas_users to avoid breaking changes. Also used for serverless instances and shared tier, whose APIs were sunset and are no longer available in newer SDK versions.
-	Realm            *RealmClient
-	BaseURL          string // Needed by organization resource.
-	TerraformVersion string // Needed by organization resource.
+	Atlas                 *matlasClient.Client
+	AtlasV2               *admin.APIClient
+	AtlasPreview          *adminpreview.APIClient
+	AtlasV220240530       *admin20240530.APIClient // Used in cluster to support deprecated attributes default_read_concern and fail_index_key_too_long in advanced_configuration.
+	AtlasV220241113       *admin20241113.APIClient // Used in teams and atlas_users to avoid breaking changes. Also used for serverless instances and shared tier, whose APIs were sunset and are no longer available in newer SDK versions.
+	Realm                 *RealmClient
+	RateLimit             *RateLimitConfig // Needed by organization resource.
+	BaseURL               string           // Needed by organization resource.
+	TerraformVersion      string           // Needed by organization resource.
+	CheckResourcePolicies bool             // Resources check the resource policies of the organization during plan if true.
 }
 
 type RealmClient struct {
@@ -122,9 +124,13 @@ type RealmClient struct {
 	terraformVersion string
 }
 
-func NewClient(c *Credentials, terraformVersion string) (*MongoDBClient, error) {
+// NewClient creates the Atlas clients. If rateLimit is nil, DefaultRateLimitConfig is used so requests are neither paced nor retried.
+func NewClient(c *Credentials, terraformVersion string, rateLimit *RateLimitConfig) (*MongoDBClient, error) {
+	if rateLimit == nil {
+		rateLimit = DefaultRateLimitConfig()
+	}
 	userAgent := UserAgent(terraformVersion)
-	client, err := getHTTPClient(c, terraformVersion)
+	client, err := getHTTPClient(c, terraformVersion, rateLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -165,6 +171,7 @@ func NewClient(c *Credentials, terraformVersion string) (*MongoDBClient, error)
 		AtlasV220241113:  sdkV220241113Client,
 		BaseURL:          c.BaseURL,
 		TerraformVersion: terraformVersion,
+		RateLimit:        rateLimit,
 		Realm: &RealmClient{
 			publicKey:        c.PublicKey,
 			privateKey:       c.PrivateKey,
@@ -175,14 +182,18 @@ func NewClient(c *Credentials, terraformVersion string) (*MongoDBClient, error)
 	return clients, nil
 }
 
-func getHTTPClient(c *Credentials, terraformVersion string) (*http.Client, error) {
+func getHTTPClient(c *Credentials, terraformVersion string, rateLimit *RateLimitConfig) (*http.Client, error) {
 	// Transport chain (outermost to innermost):
-	// userAgentTransport -> tfLoggingTransport -> {digestTransport|oauth2.Transport} -> networkLoggingTransport -> baseTransport
+	// userAgentTransport -> rateLimitTransport -> tfLoggingTransport -> {digestTransport|oauth2.Transport} -> networkLoggingTransport -> baseTransport
 	//
 	// This ordering ensures:
 	// 1. networkLoggingTransport logs ALL requests including digest auth 401 challenges
 	// 2. tfLoggingTransport only logs final authenticated requests (not sensitive auth details)
 	// 3. userAgentTransport modifies User-Agent before tfLoggingTransport logs it
+	// 4. rateLimitTransport retries go through authentication again and each attempt is logged
+	if dir := OfflineMockDir(); dir != "" {
+		return &http.Client{Transport: newUserAgentTransport(tfLoggingInterceptor(NewOfflineTransport(dir)), true)}, nil
+	}
 	transport := networkLoggingBaseTransport()
 	switch c.AuthMethod() {
 	case AccessToken:
@@ -208,6 +219,7 @@ func getHTTPClient(c *Credentials, terraformVersion string) (*http.Client, error
 	case Unknown:
 	}
 	transport = tfLoggingInterceptor(transport)
+	transport = NewRateLimitTransport(transport, rateLimit)
 	transport = newUserAgentTransport(transport, true)
 	return &http.Client{Transport: transport}, nil
 }
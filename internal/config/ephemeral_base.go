@@ -11,6 +11,8 @@ const ephemeralErrorConfigureSummary = "Unexpected Ephemeral Resource Configure
 const ephemeralErrorConfigure = "expected *EphemeralResourceData, got: %T. Please report this issue to the provider developers"
 
 type EphemeralResourceData struct {
+	Client           *MongoDBClient
+	AccessToken      string
 	ClientID         string
 	ClientSecret     string
 	BaseURL          string
@@ -1,6 +1,10 @@
 package config
 
-import "github.com/mongodb/atlas-sdk-go/auth"
+import (
+	"time"
+
+	"github.com/mongodb/atlas-sdk-go/auth"
+)
 
 // Test helpers exported only for package config_test (see service_account_test.go).
 
@@ -41,3 +45,13 @@ func SetSATokenSourceCacheClosedForTest(closed bool) {
 	defer saTokenSourceCache.mu.Unlock()
 	saTokenSourceCache.closed = closed
 }
+
+func SetRateLimitMinBackoffForTest(d time.Duration) {
+	rateLimitMinBackoff = d
+}
+
+func ResetRateLimitBucketsForTest() {
+	rateLimitBuckets.mu.Lock()
+	defer rateLimitBuckets.mu.Unlock()
+	rateLimitBuckets.buckets = make(map[string]*tokenBucket)
+}
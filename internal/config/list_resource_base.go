@@ -0,0 +1,133 @@
+package config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type ImplementedListResource interface {
+	list.ListResourceWithConfigure
+	GetName() string
+	SetClient(*MongoDBClient)
+}
+
+func AnalyticsListResourceFunc(iListResource list.ListResource) func() list.ListResource {
+	commonListResource, ok := iListResource.(ImplementedListResource)
+	if !ok {
+		panic(fmt.Sprintf("list resource %T didn't comply with the ImplementedListResource interface", iListResource))
+	}
+	return func() list.ListResource {
+		return &LSCommon{
+			ResourceName:            commonListResource.GetName(),
+			ImplementedListResource: commonListResource,
+		}
+	}
+}
+
+// LSCommon is used as an embedded struct for all framework list resources. Implements the following plugin-framework defined functions:
+// - Metadata
+// - Configure
+// Client is left empty and populated by the framework when envoking Configure method.
+// ResourceName must be defined when creating an instance of a list resource and match the name of the resource being listed.
+//
+// When used as a wrapper (ImplementedListResource is set), it intercepts List to add analytics tracking.
+type LSCommon struct {
+	ImplementedListResource // Set when used as a wrapper, nil when embedded
+	Client                  *MongoDBClient
+	ResourceName            string
+}
+
+func (l *LSCommon) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, l.ResourceName)
+}
+
+func (l *LSCommon) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	if l.ImplementedListResource != nil {
+		l.ImplementedListResource.ListResourceConfigSchema(ctx, req, resp)
+	}
+}
+
+func (l *LSCommon) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, err := configureClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(errorConfigureSummary, err.Error())
+		return
+	}
+	l.Client = client
+	if l.ImplementedListResource != nil {
+		l.ImplementedListResource.SetClient(client)
+	}
+}
+
+func (l *LSCommon) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if l.ImplementedListResource == nil {
+		return
+	}
+	ctx = AddUserAgentExtra(ctx, UserAgentExtra{
+		Name:      userAgentNameValue(l.ResourceName),
+		Operation: UserAgentOperationValueList,
+	})
+	l.ImplementedListResource.List(ctx, req, stream)
+}
+
+func (l *LSCommon) GetName() string {
+	return l.ResourceName
+}
+
+func (l *LSCommon) SetClient(client *MongoDBClient) {
+	l.Client = client
+}
+
+// ListItem is an Atlas object found by a list resource, identified by the identity attribute values of the listed resource.
+type ListItem struct {
+	Identity    map[string]string
+	DisplayName string
+}
+
+// StreamListItems sends the items to Terraform, honoring the request limit.
+// If the resource object is requested, it's populated calling Read of the listed resource with the identity attributes in the state, so the result is the same as after an import.
+func (l *LSCommon) StreamListItems(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream, rs ImplementedResource, items []ListItem) {
+	rs.SetClient(l.Client)
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			if !push(newListResult(ctx, req, rs, &items[i])) {
+				return
+			}
+		}
+	}
+}
+
+func newListResult(ctx context.Context, req list.ListRequest, rs ImplementedResource, item *ListItem) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = item.DisplayName
+	for name, value := range item.Identity {
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	if result.Diagnostics.HasError() || !req.IncludeResource {
+		return result
+	}
+	state := tfsdk.State{
+		Schema: req.ResourceSchema,
+		Raw:    tftypes.NewValue(req.ResourceSchema.Type().TerraformType(ctx), nil),
+	}
+	result.Diagnostics.Append(ImportStateFromIdentity(ctx, rs, item.Identity, &state)...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+	readResp := resource.ReadResponse{State: state}
+	rs.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	if !result.Diagnostics.HasError() {
+		result.Resource.Raw = readResp.State.Raw
+	}
+	return result
+}
@@ -0,0 +1,412 @@
+package config
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// EnvOfflineMockDir enables the offline mode when set: no request is sent to Atlas, they are served by OfflineTransport storing the objects in the directory.
+	EnvOfflineMockDir = "MONGODB_ATLAS_OFFLINE_MOCK_DIR"
+
+	offlineStoreFile           = "atlas_offline_store.json"
+	offlineDefaultItemsPerPage = 100
+)
+
+// offlineCollection describes how Atlas creates the objects of a collection so they can be read back using the same paths as the real API.
+type offlineCollection struct {
+	path *regexp.Regexp
+	// stamp sets the server-side attributes of a new object.
+	stamp func(obj map[string]any)
+	// keyAttrs are the attributes of the object, in path order, used as the item path segments. If none is set a new id is generated.
+	keyAttrs [][]string
+	// arrayBody is true when the create request sends a list of objects and returns the paginated list of the collection, e.g. access lists.
+	arrayBody bool
+}
+
+var offlineCollections = []offlineCollection{
+	{
+		path:     regexp.MustCompile(`^/api/atlas/v2/groups$`),
+		keyAttrs: [][]string{{"id"}},
+		stamp: func(obj map[string]any) {
+			setIfMissing(obj, "clusterCount", 0)
+		},
+	},
+	{
+		path:     regexp.MustCompile(`^/api/atlas/v2/groups/[^/]+/clusters$`),
+		keyAttrs: [][]string{{"name"}},
+		stamp: func(obj map[string]any) {
+			setIfMissing(obj, "stateName", "IDLE")
+			setIfMissing(obj, "paused", false)
+		},
+	},
+	{
+		path:     regexp.MustCompile(`^/api/atlas/v2/groups/[^/]+/flexClusters$`),
+		keyAttrs: [][]string{{"name"}},
+		stamp: func(obj map[string]any) {
+			setIfMissing(obj, "stateName", "IDLE")
+		},
+	},
+	{
+		path:     regexp.MustCompile(`^/api/atlas/v2/groups/[^/]+/databaseUsers$`),
+		keyAttrs: [][]string{{"databaseName"}, {"username"}},
+	},
+	{
+		path:      regexp.MustCompile(`^/api/atlas/v2/groups/[^/]+/accessList$`),
+		keyAttrs:  [][]string{{"cidrBlock", "ipAddress", "awsSecurityGroup"}},
+		arrayBody: true,
+		stamp: func(obj map[string]any) {
+			if ip, ok := obj["ipAddress"].(string); ok && ip != "" {
+				setIfMissing(obj, "cidrBlock", ip+"/32")
+			}
+		},
+	},
+	{
+		path:      regexp.MustCompile(`^/api/atlas/v2/groups/[^/]+/teams$`),
+		keyAttrs:  [][]string{{"teamId"}},
+		arrayBody: true,
+	},
+}
+
+// offlineSingleton is an endpoint that always exists in Atlas, it returns defaultBody until it's updated, e.g. project settings.
+type offlineSingleton struct {
+	path        *regexp.Regexp
+	defaultBody any
+}
+
+var offlineSingletons = []offlineSingleton{
+	{path: regexp.MustCompile(`^/api/atlas/v2/groups/[^/]+/settings$`), defaultBody: map[string]any{}},
+	{path: regexp.MustCompile(`^/api/atlas/v2/groups/[^/]+/ipAddresses$`), defaultBody: map[string]any{}},
+	{path: regexp.MustCompile(`^/api/atlas/v2/groups/[^/]+/limits$`), defaultBody: []any{}},
+	{path: regexp.MustCompile(`^/api/atlas/v2/groups/[^/]+/managedSlowMs$`), defaultBody: false},
+	{path: regexp.MustCompile(`^/api/atlas/v2/groups/[^/]+/clusters/[^/]+/processArgs$`), defaultBody: map[string]any{}},
+}
+
+// offlineStoreMutex serializes access to the store files as the SDKv2 and framework providers use different clients in the same process.
+var offlineStoreMutex sync.Mutex
+
+// OfflineTransport is an http.RoundTripper that serves the Atlas Admin API from a local JSON file instead of sending requests to Atlas.
+// It keeps the objects created with POST, PUT or PATCH and returns them in GET requests, so a Terraform configuration can be applied,
+// refreshed and destroyed without an Atlas account. Only the behavior needed by the CRUD operations is emulated:
+// all objects are ready immediately (e.g. clusters are IDLE), there is no validation and no field is computed except ids and the ones set in offlineCollections.
+// GET returns 404 for paths that are not stored objects, offlineCollections or offlineSingletons.
+type OfflineTransport struct {
+	dir string
+}
+
+// OfflineMockDir returns the directory of the offline store, empty if the offline mode is not enabled.
+func OfflineMockDir() string {
+	return getEnv(EnvOfflineMockDir)
+}
+
+func NewOfflineTransport(dir string) *OfflineTransport {
+	return &OfflineTransport{dir: dir}
+}
+
+type offlineStore struct {
+	Objects map[string]map[string]any `json:"objects"`
+}
+
+func (t *OfflineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	offlineStoreMutex.Lock()
+	defer offlineStoreMutex.Unlock()
+	store, err := t.load()
+	if err != nil {
+		return nil, err
+	}
+	reqPath := strings.TrimSuffix(req.URL.EscapedPath(), "/")
+	status, respBody, changed := store.handle(req.Method, reqPath, req.URL.Query(), body)
+	if changed {
+		if err := t.save(store); err != nil {
+			return nil, err
+		}
+	}
+	return newOfflineResponse(req, status, respBody), nil
+}
+
+func (t *OfflineTransport) load() (*offlineStore, error) {
+	store := &offlineStore{Objects: map[string]map[string]any{}}
+	data, err := os.ReadFile(filepath.Join(t.dir, offlineStoreFile))
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading offline store: %w", err)
+	}
+	if err := json.Unmarshal(data, store); err != nil {
+		return nil, fmt.Errorf("error decoding offline store: %w", err)
+	}
+	if store.Objects == nil {
+		store.Objects = map[string]map[string]any{}
+	}
+	return store, nil
+}
+
+func (t *OfflineTransport) save(store *offlineStore) error {
+	data, err := json.MarshalIndent(store, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(t.dir, 0o755); err != nil {
+		return fmt.Errorf("error creating offline store directory: %w", err)
+	}
+	return os.WriteFile(filepath.Join(t.dir, offlineStoreFile), data, 0o600)
+}
+
+func (s *offlineStore) handle(method, reqPath string, query url.Values, body []byte) (status int, respBody any, changed bool) {
+	switch method {
+	case http.MethodGet:
+		if obj, ok := s.Objects[reqPath]; ok {
+			return http.StatusOK, obj, false
+		}
+		if singleton := findOfflineSingleton(reqPath); singleton != nil {
+			return http.StatusOK, singleton.defaultBody, false
+		}
+		if findOfflineCollection(reqPath) == nil {
+			return offlineNotFound(reqPath)
+		}
+		return http.StatusOK, s.list(reqPath, query), false
+	case http.MethodPost:
+		return s.create(reqPath, query, body)
+	case http.MethodPatch, http.MethodPut:
+		var patch map[string]any
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &patch); err != nil {
+				return offlineBadRequest(err)
+			}
+		}
+		obj, found := s.Objects[reqPath]
+		if !found && isOfflineItemPath(reqPath) {
+			return offlineNotFound(reqPath)
+		}
+		if obj == nil || method == http.MethodPut {
+			obj = map[string]any{}
+		}
+		// Singletons like project settings are only updated so they're created with the first update.
+		maps.Copy(obj, patch)
+		s.Objects[reqPath] = obj
+		return http.StatusOK, obj, true
+	case http.MethodDelete:
+		if _, ok := s.Objects[reqPath]; !ok && isOfflineItemPath(reqPath) {
+			return offlineNotFound(reqPath)
+		}
+		for key := range s.Objects {
+			if key == reqPath || strings.HasPrefix(key, reqPath+"/") {
+				delete(s.Objects, key)
+			}
+		}
+		return http.StatusNoContent, nil, true
+	default:
+		return http.StatusMethodNotAllowed, offlineError(http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", method+" is not supported in offline mode"), false
+	}
+}
+
+func (s *offlineStore) create(reqPath string, query url.Values, body []byte) (status int, respBody any, changed bool) {
+	collection := findOfflineCollection(reqPath)
+	var objs []map[string]any
+	if collection != nil && collection.arrayBody {
+		if err := json.Unmarshal(body, &objs); err != nil {
+			return offlineBadRequest(err)
+		}
+	} else {
+		obj := map[string]any{}
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &obj); err != nil {
+				return offlineBadRequest(err)
+			}
+		}
+		objs = []map[string]any{obj}
+	}
+	var created map[string]any
+	for _, obj := range objs {
+		itemPath, err := newOfflineItemPath(reqPath, collection, obj)
+		if err != nil {
+			return offlineBadRequest(err)
+		}
+		if _, exists := s.Objects[itemPath]; exists && !(collection != nil && collection.arrayBody) {
+			return http.StatusConflict, offlineError(http.StatusConflict, "DUPLICATE_ITEM", itemPath+" already exists"), false
+		}
+		setIfMissing(obj, "created", time.Now().UTC().Format(time.RFC3339))
+		if collection != nil && collection.stamp != nil {
+			collection.stamp(obj)
+		}
+		s.Objects[itemPath] = obj
+		created = obj
+	}
+	if collection != nil && collection.arrayBody {
+		return http.StatusCreated, s.list(reqPath, query), true
+	}
+	return http.StatusCreated, created, true
+}
+
+// list returns the objects directly under the collection path in the Atlas paginated format.
+func (s *offlineStore) list(reqPath string, query url.Values) map[string]any {
+	depth := 1
+	if collection := findOfflineCollection(reqPath); collection != nil {
+		depth = len(collection.keyAttrs)
+	}
+	keys := []string{}
+	for key := range s.Objects {
+		rest, ok := strings.CutPrefix(key, reqPath+"/")
+		if ok && strings.Count(rest, "/") == depth-1 {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	pageNum := queryInt(query, "pageNum", 1)
+	itemsPerPage := queryInt(query, "itemsPerPage", offlineDefaultItemsPerPage)
+	start := min((pageNum-1)*itemsPerPage, len(keys))
+	end := min(start+itemsPerPage, len(keys))
+	results := make([]map[string]any, 0, end-start)
+	for _, key := range keys[start:end] {
+		results = append(results, s.Objects[key])
+	}
+	return map[string]any{
+		"results":    results,
+		"totalCount": len(keys),
+		"links":      []any{},
+	}
+}
+
+func newOfflineItemPath(reqPath string, collection *offlineCollection, obj map[string]any) (string, error) {
+	if collection == nil {
+		if name, ok := obj["name"].(string); ok && name != "" {
+			return reqPath + "/" + url.PathEscape(name), nil
+		}
+		setIfMissing(obj, "id", newOfflineID())
+		return reqPath + "/" + url.PathEscape(fmt.Sprint(obj["id"])), nil
+	}
+	itemPath := reqPath
+	for _, attrs := range collection.keyAttrs {
+		value := ""
+		for _, attr := range attrs {
+			if v, ok := obj[attr].(string); ok && v != "" {
+				value = v
+				break
+			}
+		}
+		if value == "" && slices.Equal(attrs, []string{"id"}) {
+			value = newOfflineID()
+			obj["id"] = value
+		}
+		if value == "" {
+			return "", fmt.Errorf("one of %s is required", strings.Join(attrs, ", "))
+		}
+		itemPath += "/" + url.PathEscape(value)
+	}
+	setIfMissing(obj, "id", newOfflineID())
+	return itemPath, nil
+}
+
+func findOfflineCollection(reqPath string) *offlineCollection {
+	for i := range offlineCollections {
+		if offlineCollections[i].path.MatchString(reqPath) {
+			return &offlineCollections[i]
+		}
+	}
+	return nil
+}
+
+func findOfflineSingleton(reqPath string) *offlineSingleton {
+	for i := range offlineSingletons {
+		if offlineSingletons[i].path.MatchString(reqPath) {
+			return &offlineSingletons[i]
+		}
+	}
+	return nil
+}
+
+// isOfflineItemPath returns true if the path is an object of a known collection, so updating or deleting a missing object returns 404.
+func isOfflineItemPath(reqPath string) bool {
+	for i := range offlineCollections {
+		collection := &offlineCollections[i]
+		parent := reqPath
+		for range collection.keyAttrs {
+			idx := strings.LastIndex(parent, "/")
+			if idx < 0 {
+				break
+			}
+			parent = parent[:idx]
+		}
+		if collection.path.MatchString(parent) {
+			return true
+		}
+	}
+	return false
+}
+
+func newOfflineResponse(req *http.Request, status int, body any) *http.Response {
+	resp := &http.Response{
+		StatusCode: status,
+		Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Request:    req,
+		Body:       http.NoBody,
+	}
+	if body != nil {
+		data, _ := json.Marshal(body)
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		resp.ContentLength = int64(len(data))
+	}
+	return resp
+}
+
+func offlineError(status int, errorCode, detail string) map[string]any {
+	return map[string]any{
+		"error":     status,
+		"errorCode": errorCode,
+		"reason":    http.StatusText(status),
+		"detail":    detail,
+	}
+}
+
+func offlineNotFound(reqPath string) (status int, respBody any, changed bool) {
+	return http.StatusNotFound, offlineError(http.StatusNotFound, "RESOURCE_NOT_FOUND", reqPath+" not found in offline store"), false
+}
+
+func offlineBadRequest(err error) (status int, respBody any, changed bool) {
+	return http.StatusBadRequest, offlineError(http.StatusBadRequest, "INVALID_ATTRIBUTE", err.Error()), false
+}
+
+func newOfflineID() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func setIfMissing(obj map[string]any, key string, value any) {
+	if _, ok := obj[key]; !ok {
+		obj[key] = value
+	}
+}
+
+func queryInt(query url.Values, key string, defaultValue int) int {
+	if v, err := strconv.Atoi(query.Get(key)); err == nil && v > 0 {
+		return v
+	}
+	return defaultValue
+}
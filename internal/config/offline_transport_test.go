@@ -0,0 +1,98 @@
+package config_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+func offlineRequest(t *testing.T, client *http.Client, method, path, body string) (status int, respBody map[string]any) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(t.Context(), method, "https://cloud.mongodb.com"+path, strings.NewReader(body))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	if len(data) > 0 {
+		require.NoError(t, json.Unmarshal(data, &respBody))
+	}
+	return resp.StatusCode, respBody
+}
+
+func TestOfflineTransport(t *testing.T) {
+	dir := t.TempDir()
+	client := &http.Client{Transport: config.NewOfflineTransport(dir)}
+
+	status, project := offlineRequest(t, client, http.MethodPost, "/api/atlas/v2/groups", `{"name": "p1", "orgId": "org"}`)
+	require.Equal(t, http.StatusCreated, status)
+	projectID, _ := project["id"].(string)
+	require.Len(t, projectID, 24)
+	projectPath := "/api/atlas/v2/groups/" + projectID
+
+	status, cluster := offlineRequest(t, client, http.MethodPost, projectPath+"/clusters", `{"name": "c1", "clusterType": "REPLICASET"}`)
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, "IDLE", cluster["stateName"])
+
+	status, _ = offlineRequest(t, client, http.MethodPost, projectPath+"/clusters", `{"name": "c1"}`)
+	assert.Equal(t, http.StatusConflict, status)
+
+	status, cluster = offlineRequest(t, client, http.MethodPatch, projectPath+"/clusters/c1", `{"paused": true}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, true, cluster["paused"])
+	assert.Equal(t, "REPLICASET", cluster["clusterType"])
+
+	status, _ = offlineRequest(t, client, http.MethodPost, projectPath+"/databaseUsers", `{"databaseName": "admin", "username": "u1"}`)
+	assert.Equal(t, http.StatusCreated, status)
+	status, user := offlineRequest(t, client, http.MethodGet, projectPath+"/databaseUsers/admin/u1", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "u1", user["username"])
+
+	status, accessList := offlineRequest(t, client, http.MethodPost, projectPath+"/accessList", `[{"ipAddress": "1.2.3.4"}, {"cidrBlock": "10.0.0.0/24"}]`)
+	assert.Equal(t, http.StatusCreated, status)
+	assert.InDelta(t, 2, accessList["totalCount"], 0)
+	status, entry := offlineRequest(t, client, http.MethodGet, projectPath+"/accessList/10.0.0.0%2F24", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "10.0.0.0/24", entry["cidrBlock"])
+	status, entry = offlineRequest(t, client, http.MethodGet, projectPath+"/accessList/1.2.3.4", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "1.2.3.4/32", entry["cidrBlock"])
+
+	// Objects are persisted so they're available in later Terraform commands.
+	client = &http.Client{Transport: config.NewOfflineTransport(dir)}
+	status, clusters := offlineRequest(t, client, http.MethodGet, projectPath+"/clusters?pageNum=1&itemsPerPage=10", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.InDelta(t, 1, clusters["totalCount"], 0)
+
+	status, settings := offlineRequest(t, client, http.MethodGet, projectPath+"/settings", "")
+	assert.Equal(t, http.StatusOK, status, "singletons are returned with their default value")
+	assert.NotContains(t, settings, "isDataExplorerEnabled")
+	status, settings = offlineRequest(t, client, http.MethodPatch, projectPath+"/settings", `{"isDataExplorerEnabled": true}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, true, settings["isDataExplorerEnabled"])
+	_, settings = offlineRequest(t, client, http.MethodGet, projectPath+"/settings", "")
+	assert.Equal(t, true, settings["isDataExplorerEnabled"])
+
+	status, notFound := offlineRequest(t, client, http.MethodGet, projectPath+"/unknownCollection", "")
+	assert.Equal(t, http.StatusNotFound, status, "paths that are not known collections are not returned as empty lists")
+	assert.Equal(t, "RESOURCE_NOT_FOUND", notFound["errorCode"])
+
+	status, _ = offlineRequest(t, client, http.MethodDelete, projectPath+"/clusters/c1", "")
+	assert.Equal(t, http.StatusNoContent, status)
+	status, notFound = offlineRequest(t, client, http.MethodGet, projectPath+"/clusters/c1", "")
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Equal(t, "RESOURCE_NOT_FOUND", notFound["errorCode"])
+
+	status, _ = offlineRequest(t, client, http.MethodDelete, projectPath, "")
+	assert.Equal(t, http.StatusNoContent, status)
+	status, _ = offlineRequest(t, client, http.MethodGet, projectPath+"/databaseUsers/admin/u1", "")
+	assert.Equal(t, http.StatusNotFound, status, "children are deleted with the project")
+}
@@ -0,0 +1,258 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultRateLimitMaxRetries = 3
+
+	rateLimitMaxBackoff    = 30 * time.Second
+	rateLimitMaxRetryAfter = 2 * time.Minute
+)
+
+var (
+	// rateLimitMinBackoff is a var so tests don't need to wait.
+	rateLimitMinBackoff = 1 * time.Second
+
+	rateLimitScopeRegex = regexp.MustCompile(`^/api/atlas/v[0-9]+/(groups|orgs)/([^/]+)`)
+
+	// rateLimitBuckets are shared by all the transports in the process, so the SDKv2 and framework providers
+	// served together by the provider mux don't double the rate of requests sent to the same project or organization.
+	rateLimitBuckets = struct {
+		buckets map[string]*tokenBucket
+		mu      sync.Mutex
+	}{buckets: make(map[string]*tokenBucket)}
+)
+
+// RateLimitConfig configures how requests to Atlas are paced and retried when Atlas rate limits are hit.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the maximum sustained rate of requests per project or organization, 0 means no pacing.
+	RequestsPerSecond float64
+	// Burst is the number of requests that can be sent at once before pacing starts, defaults to RequestsPerSecond rounded up.
+	Burst int
+	// MaxRetries is the number of times an idempotent request is retried when Atlas answers 429 or is unavailable.
+	// The provider rate_limit block defaults it to DefaultRateLimitMaxRetries.
+	MaxRetries int
+}
+
+// DefaultRateLimitConfig is used when the provider doesn't configure rate limits, it doesn't pace nor retry requests.
+func DefaultRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{}
+}
+
+// RateLimitTransport wraps an http.RoundTripper to avoid and recover from Atlas rate limits (HTTP 429):
+//   - Requests are paced with a token bucket per Atlas base URL and project or organization, as Atlas rate limits are applied per project or organization.
+//     Buckets are shared by all the transports in the process, the first transport using a bucket sets its rate.
+//   - When Atlas answers 429 with a Retry-After header, all requests in the same project or organization wait until then.
+//   - Idempotent requests are retried with jittered exponential backoff after 429, 502, 503 or 504 responses.
+type RateLimitTransport struct {
+	Transport http.RoundTripper
+	config    RateLimitConfig
+}
+
+func NewRateLimitTransport(transport http.RoundTripper, rateLimitConfig *RateLimitConfig) *RateLimitTransport {
+	if rateLimitConfig == nil {
+		rateLimitConfig = DefaultRateLimitConfig()
+	}
+	return &RateLimitTransport{
+		Transport: transport,
+		config:    *rateLimitConfig,
+	}
+}
+
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	bucket := t.bucket(rateLimitScope(req))
+	retries := 0
+	if isIdempotentMethod(req.Method) {
+		retries = t.config.MaxRetries
+	}
+	if retries > 0 && req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		req = req.Clone(ctx) // RoundTrip must not modify the original request.
+		if err := setGetBody(req); err != nil {
+			return nil, err
+		}
+	}
+	for attempt := 0; ; attempt++ {
+		if err := sleepContext(ctx, bucket.reserve(time.Now())); err != nil {
+			return nil, err
+		}
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+		resp, err := t.Transport.RoundTrip(attemptReq)
+		if err != nil || !isRetryableStatus(resp.StatusCode) {
+			return resp, err
+		}
+		delay, hasRetryAfter := retryAfter(resp)
+		if resp.StatusCode == http.StatusTooManyRequests && hasRetryAfter {
+			bucket.pause(time.Now().Add(delay))
+		}
+		if attempt >= retries {
+			return resp, nil
+		}
+		if !hasRetryAfter {
+			delay = backoff(attempt)
+		}
+		log.Printf("[DEBUG] Atlas answered %d to %s %s, retry %d of %d in %v", resp.StatusCode, req.Method, req.URL.Path, attempt+1, retries, delay)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *RateLimitTransport) bucket(scope string) *tokenBucket {
+	rateLimitBuckets.mu.Lock()
+	defer rateLimitBuckets.mu.Unlock()
+	bucket, ok := rateLimitBuckets.buckets[scope]
+	if !ok {
+		bucket = newTokenBucket(t.config.RequestsPerSecond, t.config.Burst)
+		rateLimitBuckets.buckets[scope] = bucket
+	}
+	return bucket
+}
+
+// rateLimitScope returns the Atlas base URL and the project or organization of the request, only the base URL for other requests.
+func rateLimitScope(req *http.Request) string {
+	baseURL := req.URL.Scheme + "://" + req.URL.Host
+	if matches := rateLimitScopeRegex.FindStringSubmatch(req.URL.Path); matches != nil {
+		return baseURL + "/" + matches[1] + "/" + matches[2]
+	}
+	return baseURL
+}
+
+// tokenBucket allows a sustained rate of requests with bursts, requests exceeding it wait for their turn.
+type tokenBucket struct {
+	last        time.Time
+	pausedUntil time.Time
+	rate        float64
+	burst       float64
+	tokens      float64
+	mu          sync.Mutex
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst <= 0 {
+		burst = max(1, int(math.Ceil(rate)))
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+// reserve takes a token and returns how long the request must wait before being sent.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var wait time.Duration
+	if b.pausedUntil.After(now) {
+		wait = b.pausedUntil.Sub(now)
+	}
+	if b.rate <= 0 {
+		return wait
+	}
+	if !b.last.IsZero() {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens < 0 {
+		wait = max(wait, time.Duration(-b.tokens/b.rate*float64(time.Second)))
+	}
+	return wait
+}
+
+func (b *tokenBucket) pause(until time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter parses the Retry-After header, which can be a number of seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, rateLimitMaxRetryAfter), true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return min(max(time.Until(date), 0), rateLimitMaxRetryAfter), true
+	}
+	return 0, false
+}
+
+// backoff returns an exponential delay with jitter so parallel requests don't retry at the same time.
+func backoff(attempt int) time.Duration {
+	delay := min(rateLimitMinBackoff<<min(attempt, 10), rateLimitMaxBackoff)
+	return delay/2 + rand.N(delay/2+1)
+}
+
+func setGetBody(req *http.Request) error {
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return err
+	}
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.Body, _ = req.GetBody()
+	return nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
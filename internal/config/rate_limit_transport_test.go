@@ -0,0 +1,181 @@
+package config_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+// sequenceTransport answers with the status codes in order, repeating the last one, and records the request bodies.
+type sequenceTransport struct {
+	headers  http.Header
+	bodies   []string
+	statuses []int
+	calls    int
+	mu       sync.Mutex
+}
+
+func (s *sequenceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	body := ""
+	if req.Body != nil {
+		data, _ := io.ReadAll(req.Body)
+		body = string(data)
+	}
+	s.bodies = append(s.bodies, body)
+	status := s.statuses[min(s.calls, len(s.statuses)-1)]
+	s.calls++
+	return &http.Response{
+		StatusCode: status,
+		Header:     s.headers.Clone(),
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+func rateLimitRequest(t *testing.T, transport http.RoundTripper, method, body string) *http.Response {
+	t.Helper()
+	return rateLimitRequestURL(t, transport, method, "https://cloud.mongodb.com/api/atlas/v2/groups/p1/clusters", body)
+}
+
+func rateLimitRequestURL(t *testing.T, transport http.RoundTripper, method, url, body string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequestWithContext(t.Context(), method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.GetBody = nil // Make sure the transport can replay bodies by itself.
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestRateLimitTransportRetries(t *testing.T) {
+	config.SetRateLimitMinBackoffForTest(time.Millisecond)
+	defer config.SetRateLimitMinBackoffForTest(time.Second)
+
+	testCases := map[string]struct {
+		method         string
+		statuses       []int
+		maxRetries     int
+		expectedStatus int
+		expectedCalls  int
+	}{
+		"GET retried after 429 until success": {
+			method:         http.MethodGet,
+			statuses:       []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries:     3,
+			expectedStatus: http.StatusOK,
+			expectedCalls:  3,
+		},
+		"GET returns the last response when retries are exhausted": {
+			method:         http.MethodGet,
+			statuses:       []int{http.StatusTooManyRequests},
+			maxRetries:     2,
+			expectedStatus: http.StatusTooManyRequests,
+			expectedCalls:  3,
+		},
+		"PUT body is sent again in retries": {
+			method:         http.MethodPut,
+			statuses:       []int{http.StatusBadGateway, http.StatusOK},
+			maxRetries:     3,
+			expectedStatus: http.StatusOK,
+			expectedCalls:  2,
+		},
+		"POST is not retried": {
+			method:         http.MethodPost,
+			statuses:       []int{http.StatusTooManyRequests, http.StatusOK},
+			maxRetries:     3,
+			expectedStatus: http.StatusTooManyRequests,
+			expectedCalls:  1,
+		},
+		"retries disabled": {
+			method:         http.MethodGet,
+			statuses:       []int{http.StatusTooManyRequests, http.StatusOK},
+			maxRetries:     0,
+			expectedStatus: http.StatusTooManyRequests,
+			expectedCalls:  1,
+		},
+		"client errors are not retried": {
+			method:         http.MethodGet,
+			statuses:       []int{http.StatusNotFound, http.StatusOK},
+			maxRetries:     3,
+			expectedStatus: http.StatusNotFound,
+			expectedCalls:  1,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config.ResetRateLimitBucketsForTest()
+			mock := &sequenceTransport{statuses: tc.statuses, headers: http.Header{}}
+			transport := config.NewRateLimitTransport(mock, &config.RateLimitConfig{MaxRetries: tc.maxRetries})
+			resp := rateLimitRequest(t, transport, tc.method, `{"paused": true}`)
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tc.expectedCalls, mock.calls)
+			for _, body := range mock.bodies {
+				assert.JSONEq(t, `{"paused": true}`, body)
+			}
+		})
+	}
+}
+
+func TestRateLimitTransportDefaultConfig(t *testing.T) {
+	config.ResetRateLimitBucketsForTest()
+	mock := &sequenceTransport{statuses: []int{http.StatusTooManyRequests, http.StatusOK}, headers: http.Header{}}
+	transport := config.NewRateLimitTransport(mock, nil)
+	resp := rateLimitRequest(t, transport, http.MethodGet, "")
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode, "requests are not retried without rate limit config")
+	assert.Equal(t, 1, mock.calls)
+}
+
+func TestRateLimitTransportRetryAfter(t *testing.T) {
+	config.ResetRateLimitBucketsForTest()
+	mock := &sequenceTransport{
+		statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+		headers:  http.Header{"Retry-After": []string{"1"}},
+	}
+	transport := config.NewRateLimitTransport(mock, &config.RateLimitConfig{MaxRetries: config.DefaultRateLimitMaxRetries})
+	start := time.Now()
+	resp := rateLimitRequest(t, transport, http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestRateLimitTransportPacing(t *testing.T) {
+	config.ResetRateLimitBucketsForTest()
+	mock := &sequenceTransport{statuses: []int{http.StatusOK}, headers: http.Header{}}
+	transport := config.NewRateLimitTransport(mock, &config.RateLimitConfig{RequestsPerSecond: 20, Burst: 1})
+	start := time.Now()
+	for range 3 {
+		rateLimitRequest(t, transport, http.MethodGet, "")
+	}
+	// The first request uses the burst, the next ones wait 50ms each.
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	assert.Equal(t, 3, mock.calls)
+}
+
+func TestRateLimitTransportSharedBuckets(t *testing.T) {
+	config.ResetRateLimitBucketsForTest()
+	mock := &sequenceTransport{statuses: []int{http.StatusOK}, headers: http.Header{}}
+	rateLimit := &config.RateLimitConfig{RequestsPerSecond: 20, Burst: 1}
+	// The SDKv2 and framework providers have their own transports but they share the limits of each project.
+	transports := []http.RoundTripper{config.NewRateLimitTransport(mock, rateLimit), config.NewRateLimitTransport(mock, rateLimit)}
+	start := time.Now()
+	for i := range 3 {
+		rateLimitRequest(t, transports[i%2], http.MethodGet, "")
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	// Other projects and Atlas base URLs have their own buckets so they don't wait.
+	start = time.Now()
+	rateLimitRequestURL(t, transports[0], http.MethodGet, "https://cloud.mongodb.com/api/atlas/v2/groups/p2/clusters", "")
+	rateLimitRequestURL(t, transports[1], http.MethodGet, "https://cloud.mongodbgov.com/api/atlas/v2/groups/p1/clusters", "")
+	assert.Less(t, time.Since(start), 40*time.Millisecond)
+}
@@ -54,11 +54,16 @@ func AnalyticsResourceFunc(iResource resource.Resource) func() resource.Resource
 // analyticsResource wraps an ImplementedResource with RSCommon to add analytics tracking.
 // We cannot return iResource directly because we need to intercept all CRUD operations
 // to inject provider_meta information into the context before calling the actual resource methods.
+// Resources implementing resource.ResourceWithIdentity are wrapped with rsCommonWithIdentity to also keep their identity up to date.
 func analyticsResource(iResource ImplementedResource) resource.Resource {
-	return &RSCommon{
+	rsCommon := &RSCommon{
 		ResourceName:        iResource.GetName(),
 		ImplementedResource: iResource,
 	}
+	if _, ok := iResource.(resource.ResourceWithIdentity); ok {
+		return &rsCommonWithIdentity{RSCommon: rsCommon}
+	}
+	return rsCommon
 }
 
 // RSCommon is used as an embedded struct for all framework resources. Implements the following plugin-framework defined functions:
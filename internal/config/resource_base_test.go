@@ -21,4 +21,6 @@ func TestNoResourceInterfaceLoss(t *testing.T) {
 	assert.True(t, ok)
 	_, ok = analyticsResource.(resource.ResourceWithImportState)
 	assert.True(t, ok)
+	_, ok = analyticsResource.(resource.ResourceWithIdentity)
+	assert.True(t, ok)
 }
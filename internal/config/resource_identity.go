@@ -0,0 +1,128 @@
+package config
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ResourceWithIdentityFromState can be implemented by resources with identity whose identity attributes are not all root string attributes with the same name in the resource schema.
+// By default, identity attributes are copied from the state after Create, Read and Update.
+type ResourceWithIdentityFromState interface {
+	// IdentityFromState returns the identity attribute values of the resource in the state.
+	IdentityFromState(ctx context.Context, state *tfsdk.State) (map[string]string, diag.Diagnostics)
+}
+
+// ResourceWithImportStateFromIdentity can be implemented by resources with identity whose Read needs more attributes than the identity ones, e.g. an encoded id.
+// By default, identity attributes are copied to the state when importing with an identity.
+type ResourceWithImportStateFromIdentity interface {
+	// ImportStateFromIdentity sets in the state the attributes needed by Read from the identity attribute values.
+	ImportStateFromIdentity(ctx context.Context, identity map[string]string, state *tfsdk.State) diag.Diagnostics
+}
+
+// rsCommonWithIdentity wraps RSCommon for resources implementing resource.ResourceWithIdentity.
+// It's a different type because the framework considers that a resource supports identity if it implements IdentitySchema.
+// All identity attributes are strings, see conversion.IdentitySchemaFromResource.
+type rsCommonWithIdentity struct {
+	*RSCommon
+}
+
+func (r *rsCommonWithIdentity) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	r.ImplementedResource.(resource.ResourceWithIdentity).IdentitySchema(ctx, req, resp)
+}
+
+func (r *rsCommonWithIdentity) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.RSCommon.Create(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(setIdentityFromState(ctx, r.ImplementedResource, &resp.State, resp.Identity)...)
+	}
+}
+
+func (r *rsCommonWithIdentity) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.RSCommon.Read(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(setIdentityFromState(ctx, r.ImplementedResource, &resp.State, resp.Identity)...)
+	}
+}
+
+func (r *rsCommonWithIdentity) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.RSCommon.Update(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(setIdentityFromState(ctx, r.ImplementedResource, &resp.State, resp.Identity)...)
+	}
+}
+
+// ImportState supports import blocks with identity, otherwise the resource ImportState is called to parse the import ID.
+func (r *rsCommonWithIdentity) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" || req.Identity == nil || req.Identity.Raw.IsNull() {
+		r.RSCommon.ImportState(ctx, req, resp)
+		return
+	}
+	ctx = AddUserAgentExtra(ctx, UserAgentExtra{
+		Name:      userAgentNameValue(r.ResourceName),
+		Operation: UserAgentOperationValueImport,
+	})
+	identity, diags := identityValues(ctx, req.Identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(ImportStateFromIdentity(ctx, r.ImplementedResource, identity, &resp.State)...)
+}
+
+// ImportStateFromIdentity sets in the state the attributes needed by the resource Read from the identity attribute values.
+func ImportStateFromIdentity(ctx context.Context, r resource.Resource, identity map[string]string, state *tfsdk.State) diag.Diagnostics {
+	if custom, ok := r.(ResourceWithImportStateFromIdentity); ok {
+		return custom.ImportStateFromIdentity(ctx, identity, state)
+	}
+	var diags diag.Diagnostics
+	for name, value := range identity {
+		diags.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	return diags
+}
+
+// IdentityFromState returns the identity attribute values of a resource in the state.
+func IdentityFromState(ctx context.Context, r resource.Resource, state *tfsdk.State, identity *tfsdk.ResourceIdentity) (map[string]string, diag.Diagnostics) {
+	if custom, ok := r.(ResourceWithIdentityFromState); ok {
+		return custom.IdentityFromState(ctx, state)
+	}
+	var diags diag.Diagnostics
+	values := make(map[string]string)
+	for name := range identity.Schema.GetAttributes() {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...)
+		if !value.IsNull() && !value.IsUnknown() {
+			values[name] = value.ValueString()
+		}
+	}
+	return values, diags
+}
+
+func setIdentityFromState(ctx context.Context, r resource.Resource, state *tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	if identity == nil || state.Raw.IsNull() {
+		return nil
+	}
+	values, diags := IdentityFromState(ctx, r, state, identity)
+	for name, value := range values {
+		diags.Append(identity.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	return diags
+}
+
+func identityValues(ctx context.Context, identity *tfsdk.ResourceIdentity) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	values := make(map[string]string)
+	for name := range identity.Schema.GetAttributes() {
+		var value types.String
+		diags.Append(identity.GetAttribute(ctx, path.Root(name), &value)...)
+		if !value.IsNull() && !value.IsUnknown() {
+			values[name] = value.ValueString()
+		}
+	}
+	return values, diags
+}
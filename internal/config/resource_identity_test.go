@@ -0,0 +1,237 @@
+package config_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/alertconfiguration"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/encryptionatrest"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/projectipaccesslist"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamconnection"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprocessor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type identityTestModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+}
+
+// identityTestResource is a resource with identity that keeps the plan as the state, like a resource whose API echoes the request.
+type identityTestResource struct{}
+
+func (r *identityTestResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+}
+
+func (r *identityTestResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":         schema.StringAttribute{Computed: true},
+			"project_id": schema.StringAttribute{Required: true},
+			"name":       schema.StringAttribute{Required: true},
+		},
+	}
+}
+
+func (r *identityTestResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(schemaResp.Schema, "project_id", "name")
+}
+
+func (r *identityTestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.State.Raw = req.Plan.Raw
+}
+
+func (r *identityTestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+func (r *identityTestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.State.Raw = req.Plan.Raw
+}
+
+func (r *identityTestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *identityTestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *identityTestResource) SetClient(client *config.MongoDBClient) {}
+
+func (r *identityTestResource) GetName() string {
+	return "identity_test"
+}
+
+func resourceSchemas(t *testing.T, r resource.Resource) (rsSchema resource.SchemaResponse, identitySchema resource.IdentitySchemaResponse) {
+	t.Helper()
+	r.Schema(t.Context(), resource.SchemaRequest{}, &rsSchema)
+	require.False(t, rsSchema.Diagnostics.HasError())
+	withIdentity, ok := r.(resource.ResourceWithIdentity)
+	require.True(t, ok)
+	withIdentity.IdentitySchema(t.Context(), resource.IdentitySchemaRequest{}, &identitySchema)
+	require.False(t, identitySchema.Diagnostics.HasError())
+	return rsSchema, identitySchema
+}
+
+func emptyState(t *testing.T, rsSchema *resource.SchemaResponse) tfsdk.State {
+	t.Helper()
+	return tfsdk.State{Schema: rsSchema.Schema, Raw: tftypes.NewValue(rsSchema.Schema.Type().TerraformType(t.Context()), nil)}
+}
+
+func emptyIdentity(t *testing.T, identitySchema *resource.IdentitySchemaResponse) *tfsdk.ResourceIdentity {
+	t.Helper()
+	return &tfsdk.ResourceIdentity{Schema: identitySchema.IdentitySchema, Raw: tftypes.NewValue(identitySchema.IdentitySchema.Type().TerraformType(t.Context()), nil)}
+}
+
+// attributeGetter is implemented by tfsdk.State and tfsdk.ResourceIdentity.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target any) diag.Diagnostics
+}
+
+func stringAttributes(t *testing.T, getter attributeGetter, names ...string) map[string]string {
+	t.Helper()
+	values := make(map[string]string, len(names))
+	for _, name := range names {
+		var value types.String
+		require.False(t, getter.GetAttribute(t.Context(), path.Root(name), &value).HasError(), name)
+		values[name] = value.ValueString()
+	}
+	return values
+}
+
+func TestResourceIdentityCreateReadUpdate(t *testing.T) {
+	ctx := t.Context()
+	r := config.AnalyticsResourceFunc(&identityTestResource{})()
+	rsSchema, identitySchema := resourceSchemas(t, r)
+	state := emptyState(t, &rsSchema)
+	require.False(t, state.Set(ctx, &identityTestModel{ID: types.StringValue("id"), ProjectID: types.StringValue("projectID"), Name: types.StringValue("name")}).HasError())
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+	expected := map[string]string{"project_id": "projectID", "name": "name"}
+	identityValues := func(identity *tfsdk.ResourceIdentity) map[string]string {
+		return stringAttributes(t, identity, "project_id", "name")
+	}
+
+	createResp := resource.CreateResponse{State: emptyState(t, &rsSchema), Identity: emptyIdentity(t, &identitySchema)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	require.False(t, createResp.Diagnostics.HasError())
+	assert.Equal(t, expected, identityValues(createResp.Identity), "Create sets the identity")
+
+	readResp := resource.ReadResponse{State: state, Identity: emptyIdentity(t, &identitySchema)}
+	r.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+	require.False(t, readResp.Diagnostics.HasError())
+	assert.Equal(t, expected, identityValues(readResp.Identity), "Read sets the identity, e.g. after upgrading from a version without identity")
+
+	updateResp := resource.UpdateResponse{State: state, Identity: emptyIdentity(t, &identitySchema)}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &updateResp)
+	require.False(t, updateResp.Diagnostics.HasError())
+	assert.Equal(t, expected, identityValues(updateResp.Identity), "Update sets the identity")
+}
+
+func TestResourceIdentityImportState(t *testing.T) {
+	ctx := t.Context()
+	r, ok := config.AnalyticsResourceFunc(&identityTestResource{})().(resource.ResourceWithImportState)
+	require.True(t, ok)
+	rsSchema, identitySchema := resourceSchemas(t, r)
+	identity := emptyIdentity(t, &identitySchema)
+	require.False(t, identity.Set(ctx, &struct {
+		ProjectID types.String `tfsdk:"project_id"`
+		Name      types.String `tfsdk:"name"`
+	}{ProjectID: types.StringValue("projectID"), Name: types.StringValue("name")}).HasError())
+	testCases := map[string]struct {
+		req      resource.ImportStateRequest
+		expected map[string]string
+	}{
+		"identity attributes are set in the state": {
+			req:      resource.ImportStateRequest{Identity: identity},
+			expected: map[string]string{"id": "", "project_id": "projectID", "name": "name"},
+		},
+		"import ID uses the resource ImportState": {
+			req:      resource.ImportStateRequest{ID: "importID"},
+			expected: map[string]string{"id": "importID", "project_id": "", "name": ""},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := resource.ImportStateResponse{State: emptyState(t, &rsSchema), Identity: emptyIdentity(t, &identitySchema)}
+			r.ImportState(ctx, tc.req, &resp)
+			require.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tc.expected, stringAttributes(t, &resp.State, "id", "project_id", "name"))
+		})
+	}
+}
+
+func TestResourceIdentityCustomMappings(t *testing.T) {
+	const (
+		projectID = "664619d870c247237f4b86a6"
+		workspace = "workspace"
+	)
+	testCases := map[string]struct {
+		resource      resource.Resource
+		identity      map[string]string
+		expectedState map[string]string
+		roundTrip     bool // Resource implements IdentityFromState, so the import state can be converted back to the identity.
+	}{
+		"alert configuration": {
+			resource: alertconfiguration.Resource(),
+			identity: map[string]string{"project_id": projectID, "alert_configuration_id": "alertID"},
+			expectedState: map[string]string{
+				"project_id": projectID,
+				"id":         conversion.EncodeStateID(map[string]string{alertconfiguration.EncodedIDKeyAlertID: "alertID", alertconfiguration.EncodedIDKeyProjectID: projectID}),
+			},
+		},
+		"encryption at rest": {
+			resource:      encryptionatrest.Resource(),
+			identity:      map[string]string{"project_id": projectID},
+			expectedState: map[string]string{"id": projectID},
+		},
+		"project ip access list": {
+			resource:      projectipaccesslist.Resource(),
+			identity:      map[string]string{"project_id": projectID, "entry": "10.0.0.0/16"},
+			expectedState: map[string]string{"id": conversion.EncodeStateID(map[string]string{"project_id": projectID, "entry": "10.0.0.0/16"})},
+			roundTrip:     true,
+		},
+		"stream processor": {
+			resource:      streamprocessor.Resource(),
+			identity:      map[string]string{"project_id": projectID, "workspace_name": workspace, "processor_name": "processor"},
+			expectedState: map[string]string{"project_id": projectID, "instance_name": workspace, "workspace_name": workspace, "processor_name": "processor"},
+			roundTrip:     true,
+		},
+		"stream connection": {
+			resource:      streamconnection.Resource(),
+			identity:      map[string]string{"project_id": projectID, "workspace_name": workspace, "connection_name": "connection"},
+			expectedState: map[string]string{"project_id": projectID, "instance_name": workspace, "workspace_name": workspace, "connection_name": "connection"},
+			roundTrip:     true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			rsSchema, identitySchema := resourceSchemas(t, tc.resource)
+			state := emptyState(t, &rsSchema)
+			require.False(t, config.ImportStateFromIdentity(ctx, tc.resource, tc.identity, &state).HasError())
+			names := make([]string, 0, len(tc.expectedState))
+			for attrName := range tc.expectedState {
+				names = append(names, attrName)
+			}
+			assert.Equal(t, tc.expectedState, stringAttributes(t, &state, names...))
+			if !tc.roundTrip {
+				return
+			}
+			identity, diags := config.IdentityFromState(ctx, tc.resource, &state, emptyIdentity(t, &identitySchema))
+			require.False(t, diags.HasError())
+			assert.Equal(t, tc.identity, identity)
+		})
+	}
+}
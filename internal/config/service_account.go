@@ -12,8 +12,8 @@ import (
 	"golang.org/x/oauth2"
 )
 
-// Renew token if it expires within 10 minutes to avoid authentication errors during Atlas API calls.
-const saTokenExpiryBuffer = 10 * time.Minute
+// SATokenExpiryBuffer is how long before expiry a cached Service Account token is renewed, to avoid authentication errors during Atlas API calls.
+const SATokenExpiryBuffer = 10 * time.Minute
 
 type saTokenSourceEntry struct {
 	tokenSource  auth.TokenSource
@@ -42,7 +42,7 @@ func defaultCreateTokenSource(clientID, clientSecret, baseURL, terraformVersion
 	// Use a new context to avoid "context canceled" errors as the token source is reused and can outlast the callee context.
 	ctx := context.WithValue(context.Background(), auth.HTTPClient, NewOAuthHTTPClient(terraformVersion))
 	conf := GetServiceAccountConfig(clientID, clientSecret, baseURL)
-	tokenSource := oauth2.ReuseTokenSourceWithExpiry(nil, conf.TokenSource(ctx), saTokenExpiryBuffer)
+	tokenSource := oauth2.ReuseTokenSourceWithExpiry(nil, conf.TokenSource(ctx), SATokenExpiryBuffer)
 	if _, err := tokenSource.Token(); err != nil { // Retrieve token to fail-fast if credentials are invalid.
 		return nil, err
 	}
@@ -95,6 +95,16 @@ func getTokenSource(clientID, clientSecret, baseURL, terraformVersion string) (a
 	return tokenSource, nil
 }
 
+// ServiceAccountToken returns a token from the cached token source of the Service Account, the same one used by the provider client.
+// The token is reused until it's within SATokenExpiryBuffer of its expiry, then a new one is requested.
+func ServiceAccountToken(clientID, clientSecret, baseURL, terraformVersion string) (*oauth2.Token, error) {
+	tokenSource, err := getTokenSource(clientID, clientSecret, baseURL, terraformVersion)
+	if err != nil {
+		return nil, err
+	}
+	return tokenSource.Token()
+}
+
 func NormalizeBaseURL(baseURL string) string {
 	return strings.TrimRight(baseURL, "/")
 }
@@ -159,3 +159,23 @@ func TestGetTokenSource_ErrorsWhenClosed(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "already closed")
 }
+
+func TestServiceAccountToken_UsesCachedTokenSource(t *testing.T) {
+	resetSATokenSourceCache(t)
+
+	calls := 0
+	config.SetCreateTokenSourceForTest(func(clientID, clientSecret, baseURL, terraformVersion string) (auth.TokenSource, error) {
+		calls++
+		return staticTokenSource{token: &oauth2.Token{AccessToken: "tok-" + clientID, TokenType: "Bearer"}}, nil
+	})
+
+	_, err := config.GetTokenSourceForTest("client-a", "secret-a", "https://cloud-qa.mongodb.com", "1.0.0")
+	require.NoError(t, err)
+	token, err := config.ServiceAccountToken("client-a", "secret-a", "https://cloud-qa.mongodb.com/", "1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "tok-client-a", token.AccessToken)
+	assert.Equal(t, 1, calls)
+
+	_, err = config.ServiceAccountToken("client-a", "secret-b", "https://cloud-qa.mongodb.com", "1.0.0")
+	require.Error(t, err)
+}
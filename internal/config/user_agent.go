@@ -25,6 +25,8 @@ const (
 	UserAgentOperationValueOpen         = "open"
 	UserAgentOperationValueRenew        = "renew"
 	UserAgentOperationValueClose        = "close"
+	UserAgentOperationValueList         = "list"
+	UserAgentOperationValueInvoke       = "invoke"
 )
 
 // UserAgentExtra holds additional metadata to be appended to the User-Agent header and context.
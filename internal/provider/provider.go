@@ -2,13 +2,19 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/serviceapi/metricintegration"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -21,15 +27,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/providerfunction"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/accesstoken"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/advancedcluster"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/alertconfiguration"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/apikeyprojectassignment"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/atlasuser"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/cloudbackupsnapshot"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/clouduserorgassignment"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/clouduserprojectassignment"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/clouduserteamassignment"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/clustercostestimate"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/clusterhealth"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/controlplaneipaddresses"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/databaseeffectivepermissions"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/databaseuser"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/databaseusercredentials"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/encryptionatrest"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/encryptionatrestprivateendpoint"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/flexcluster"
@@ -51,8 +64,10 @@ import (
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streaminstance"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprivatelinkendpoint"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprocessor"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprocessorstats"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamworkspace"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/teamprojectassignment"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/x509authenticationdatabaseuser"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/serviceapi/aimodelapikey"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/serviceapi/aimodelorgapikey"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/serviceapi/aimodelorgratelimit"
@@ -82,29 +97,40 @@ type MongodbatlasProvider struct {
 }
 
 var _ provider.ProviderWithEphemeralResources = &MongodbatlasProvider{}
+var _ provider.ProviderWithFunctions = &MongodbatlasProvider{}
+var _ provider.ProviderWithListResources = &MongodbatlasProvider{}
+var _ provider.ProviderWithActions = &MongodbatlasProvider{}
 
 type tfModel struct {
-	Region               types.String        `tfsdk:"region"`
-	PrivateKey           types.String        `tfsdk:"private_key"`
-	BaseURL              types.String        `tfsdk:"base_url"`
-	RealmBaseURL         types.String        `tfsdk:"realm_base_url"`
-	SecretName           types.String        `tfsdk:"secret_name"`
-	PublicKey            types.String        `tfsdk:"public_key"`
-	StsEndpoint          types.String        `tfsdk:"sts_endpoint"`
-	AwsAccessKeyID       types.String        `tfsdk:"aws_access_key_id"`
-	AwsSecretAccessKeyID types.String        `tfsdk:"aws_secret_access_key"`
-	AwsSessionToken      types.String        `tfsdk:"aws_session_token"`
-	ClientID             types.String        `tfsdk:"client_id"`
-	ClientSecret         types.String        `tfsdk:"client_secret"`
-	AccessToken          types.String        `tfsdk:"access_token"`
-	AssumeRole           []tfAssumeRoleModel `tfsdk:"assume_role"`
-	IsMongodbGovCloud    types.Bool          `tfsdk:"is_mongodbgov_cloud"`
+	Region                types.String        `tfsdk:"region"`
+	PrivateKey            types.String        `tfsdk:"private_key"`
+	BaseURL               types.String        `tfsdk:"base_url"`
+	RealmBaseURL          types.String        `tfsdk:"realm_base_url"`
+	SecretName            types.String        `tfsdk:"secret_name"`
+	PublicKey             types.String        `tfsdk:"public_key"`
+	StsEndpoint           types.String        `tfsdk:"sts_endpoint"`
+	AwsAccessKeyID        types.String        `tfsdk:"aws_access_key_id"`
+	AwsSecretAccessKeyID  types.String        `tfsdk:"aws_secret_access_key"`
+	AwsSessionToken       types.String        `tfsdk:"aws_session_token"`
+	ClientID              types.String        `tfsdk:"client_id"`
+	ClientSecret          types.String        `tfsdk:"client_secret"`
+	AccessToken           types.String        `tfsdk:"access_token"`
+	AssumeRole            []tfAssumeRoleModel `tfsdk:"assume_role"`
+	RateLimit             []tfRateLimitModel  `tfsdk:"rate_limit"`
+	IsMongodbGovCloud     types.Bool          `tfsdk:"is_mongodbgov_cloud"`
+	CheckResourcePolicies types.Bool          `tfsdk:"check_resource_policies"`
 }
 
 type tfAssumeRoleModel struct {
 	RoleARN types.String `tfsdk:"role_arn"`
 }
 
+type tfRateLimitModel struct {
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	Burst             types.Int64   `tfsdk:"burst"`
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+}
+
 func (p *MongodbatlasProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "mongodbatlas"
 	resp.Version = version.ProviderVersion
@@ -134,6 +160,7 @@ func (p *MongodbatlasProvider) Schema(ctx context.Context, req provider.SchemaRe
 	resp.Schema = schema.Schema{
 		Blocks: map[string]schema.Block{
 			"assume_role": fwAssumeRoleSchema,
+			"rate_limit":  fwRateLimitSchema,
 		},
 		Attributes: map[string]schema.Attribute{
 			"public_key": schema.StringAttribute{
@@ -193,6 +220,10 @@ func (p *MongodbatlasProvider) Schema(ctx context.Context, req provider.SchemaRe
 				Optional:    true,
 				Description: "MongoDB Atlas Access Token for Service Account.",
 			},
+			"check_resource_policies": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Warn during plan about the resource policies of the organization that may restrict changes to clusters, IP access list entries and private networking resources. Disabled by default.",
+			},
 		},
 	}
 }
@@ -209,11 +240,36 @@ var fwAssumeRoleSchema = schema.ListNestedBlock{
 	},
 }
 
+var fwRateLimitSchema = schema.ListNestedBlock{
+	Validators: []validator.List{listvalidator.SizeAtMost(1)},
+	NestedObject: schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum sustained number of requests per second sent to each Atlas project or organization. Requests exceeding it wait for their turn. By default requests are not paced.",
+				Validators:  []validator.Float64{float64validator.AtLeast(0)},
+			},
+			"burst": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of requests that can be sent at once to each Atlas project or organization before pacing starts. Defaults to `requests_per_second` rounded up.",
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of times an idempotent request is retried when Atlas answers with HTTP 429, 502, 503 or 504. The `Retry-After` header is honored, otherwise retries use exponential backoff with jitter. Defaults to 3 when the `rate_limit` block is set, 0 disables retries. Requests are not retried if the `rate_limit` block is not set.",
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+		},
+	},
+}
+
 func (p *MongodbatlasProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	providerVars := getProviderVars(ctx, req, resp)
+	var data tfModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	providerVars := getProviderVars(&data)
 	envVars := config.NewEnvVars()
 	c, err := config.GetCredentials(ctx, providerVars, envVars, getAWSCredentials)
 	if err != nil {
@@ -224,22 +280,29 @@ func (p *MongodbatlasProvider) Configure(ctx context.Context, req provider.Confi
 		resp.Diagnostics.AddError("Error getting credentials for provider", c.Errors())
 		return
 	}
-	if c.Warnings() != "" {
+	if dir := config.OfflineMockDir(); dir != "" {
+		resp.Diagnostics.AddWarning("Offline mode enabled", fmt.Sprintf("%s is set, no requests are sent to MongoDB Atlas. Objects are stored in %s.", config.EnvOfflineMockDir, dir))
+	} else if c.Warnings() != "" {
 		resp.Diagnostics.AddWarning("Warning getting credentials for provider", c.Warnings())
 	}
 	// Not part of c.Warnings() as that only sees the resolved credentials; this needs the pre-resolution provider/env inputs to detect values dropped on the AWS path.
 	if w := config.AWSSecretsManagerIgnoredWarning(providerVars, envVars); w != "" {
 		resp.Diagnostics.AddWarning("Configuration ignored when using AWS Secrets Manager", w)
 	}
-	client, err := config.NewClient(c, req.TerraformVersion)
+	client, err := config.NewClient(c, req.TerraformVersion, getRateLimitConfig(&data))
 	if err != nil {
 		resp.Diagnostics.AddError("Error initializing provider", err.Error())
 		return
 	}
+	client.CheckResourcePolicies = data.CheckResourcePolicies.ValueBool()
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
+	resp.ActionData = client
 
 	resp.EphemeralResourceData = &config.EphemeralResourceData{
+		Client:           client,
+		AccessToken:      c.AccessToken,
 		ClientID:         c.ClientID,
 		ClientSecret:     c.ClientSecret,
 		BaseURL:          c.BaseURL,
@@ -247,12 +310,22 @@ func (p *MongodbatlasProvider) Configure(ctx context.Context, req provider.Confi
 	}
 }
 
-func getProviderVars(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) *config.Vars {
-	var data tfModel
-	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
+func getRateLimitConfig(data *tfModel) *config.RateLimitConfig {
+	if len(data.RateLimit) == 0 {
 		return nil
 	}
+	rateLimit := &config.RateLimitConfig{
+		RequestsPerSecond: data.RateLimit[0].RequestsPerSecond.ValueFloat64(),
+		Burst:             int(data.RateLimit[0].Burst.ValueInt64()),
+		MaxRetries:        config.DefaultRateLimitMaxRetries,
+	}
+	if maxRetries := data.RateLimit[0].MaxRetries; !maxRetries.IsNull() {
+		rateLimit.MaxRetries = int(maxRetries.ValueInt64())
+	}
+	return rateLimit
+}
+
+func getProviderVars(data *tfModel) *config.Vars {
 	assumeRoleARN := ""
 	if len(data.AssumeRole) > 0 {
 		assumeRoleARN = data.AssumeRole[0].RoleARN.ValueString()
@@ -302,6 +375,8 @@ func (p *MongodbatlasProvider) DataSources(context.Context) []func() datasource.
 		projectipaddresses.DataSource,
 		streamprocessor.DataSource,
 		streamprocessor.PluralDataSource,
+		streamprocessorstats.DataSource,
+		streamprocessorstats.PluralDataSource,
 		encryptionatrest.DataSource,
 		encryptionatrestprivateendpoint.DataSource,
 		encryptionatrestprivateendpoint.PluralDataSource,
@@ -326,6 +401,9 @@ func (p *MongodbatlasProvider) DataSources(context.Context) []func() datasource.
 		apikeyprojectassignment.PluralDataSource,
 		advancedcluster.DataSource,
 		advancedcluster.PluralDataSource,
+		clustercostestimate.DataSource,
+		databaseeffectivepermissions.DataSource,
+		clusterhealth.DataSource,
 		serviceaccount.DataSource,
 		serviceaccount.PluralDataSource,
 		serviceaccountsecret.DataSource,
@@ -408,6 +486,10 @@ func (p *MongodbatlasProvider) Resources(context.Context) []func() resource.Reso
 func (p *MongodbatlasProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
 	ephemeralResources := []func() ephemeral.EphemeralResource{
 		serviceaccountjwt.New,
+		databaseusercredentials.New,
+		x509authenticationdatabaseuser.EphemeralResource,
+		databaseuser.EphemeralResource,
+		accesstoken.New,
 	}
 	ephemeralResourcesWithAnalytics := []func() ephemeral.EphemeralResource{}
 	for _, ephemeralResourceFunc := range ephemeralResources {
@@ -416,6 +498,44 @@ func (p *MongodbatlasProvider) EphemeralResources(context.Context) []func() ephe
 	return ephemeralResourcesWithAnalytics
 }
 
+func (p *MongodbatlasProvider) ListResources(context.Context) []func() list.ListResource {
+	listResources := []func() list.ListResource{
+		project.ListResource,
+		advancedcluster.ListResource,
+		databaseuser.ListResource,
+		projectipaccesslist.ListResource,
+	}
+	listResourcesWithAnalytics := []func() list.ListResource{}
+	for _, listResourceFunc := range listResources {
+		listResourcesWithAnalytics = append(listResourcesWithAnalytics, config.AnalyticsListResourceFunc(listResourceFunc()))
+	}
+	return listResourcesWithAnalytics
+}
+
+func (p *MongodbatlasProvider) Actions(context.Context) []func() action.Action {
+	actions := []func() action.Action{
+		streamprocessor.StartAction,
+		streamprocessor.StopAction,
+		advancedcluster.TestFailoverAction,
+		advancedcluster.PauseAction,
+		advancedcluster.ResumeAction,
+		cloudbackupsnapshot.TakeSnapshotAction,
+	}
+	actionsWithAnalytics := []func() action.Action{}
+	for _, actionFunc := range actions {
+		actionsWithAnalytics = append(actionsWithAnalytics, config.AnalyticsActionFunc(actionFunc()))
+	}
+	return actionsWithAnalytics
+}
+
+func (p *MongodbatlasProvider) Functions(context.Context) []func() function.Function {
+	return []func() function.Function{
+		providerfunction.NewParseConnectionString,
+		providerfunction.NewBuildSRVConnectionString,
+		providerfunction.NewParseImportID,
+	}
+}
+
 func NewFrameworkProvider() provider.Provider {
 	return &MongodbatlasProvider{}
 }
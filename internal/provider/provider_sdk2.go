@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/accesslistapikey"
@@ -82,6 +83,7 @@ func NewSdkV2Provider() *schema.Provider {
 				Description: "MongoDB Atlas Base URL default to gov",
 			},
 			"assume_role": assumeRoleSchema(),
+			"rate_limit":  rateLimitSchema(),
 			"secret_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -127,6 +129,11 @@ func NewSdkV2Provider() *schema.Provider {
 				Optional:    true,
 				Description: "MongoDB Atlas Access Token for Service Account.",
 			},
+			"check_resource_policies": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Warn during plan about the resource policies of the organization that may restrict changes to clusters, IP access list entries and private networking resources. Disabled by default.",
+			},
 		},
 		DataSourcesMap: getDataSourcesMap(),
 		ResourcesMap:   getResourcesMap(),
@@ -170,6 +177,37 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "Maximum sustained number of requests per second sent to each Atlas project or organization. Requests exceeding it wait for their turn. By default requests are not paced.",
+					ValidateFunc: validation.FloatAtLeast(0),
+				},
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Number of requests that can be sent at once to each Atlas project or organization before pacing starts. Defaults to `requests_per_second` rounded up.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      config.DefaultRateLimitMaxRetries,
+					Description:  "Number of times an idempotent request is retried when Atlas answers with HTTP 429, 502, 503 or 504. The `Retry-After` header is honored, otherwise retries use exponential backoff with jitter. Defaults to 3 when the `rate_limit` block is set, 0 disables retries. Requests are not retried if the `rate_limit` block is not set.",
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	}
+}
+
 func getDataSourcesMap() map[string]*schema.Resource {
 	dataSourcesMap := map[string]*schema.Resource{
 		"mongodbatlas_custom_db_role":                        customdbrole.DataSource(),
@@ -309,14 +347,29 @@ func providerConfigure(provider *schema.Provider) func(ctx context.Context, d *s
 		if c.Errors() != "" {
 			return nil, nil
 		}
-		client, err := config.NewClient(c, provider.TerraformVersion)
+		client, err := config.NewClient(c, provider.TerraformVersion, getSDKv2RateLimitConfig(d))
 		if err != nil {
 			return nil, append(diags, diag.FromErr(fmt.Errorf("error initializing provider: %w", err))...)
 		}
+		client.CheckResourcePolicies = d.Get("check_resource_policies").(bool)
 		return client, nil
 	}
 }
 
+func getSDKv2RateLimitConfig(d *schema.ResourceData) *config.RateLimitConfig {
+	rateLimits := d.Get("rate_limit").([]any)
+	if len(rateLimits) == 0 {
+		return nil
+	}
+	rateLimit := &config.RateLimitConfig{MaxRetries: config.DefaultRateLimitMaxRetries}
+	if v, ok := rateLimits[0].(map[string]any); ok {
+		rateLimit.RequestsPerSecond = v["requests_per_second"].(float64)
+		rateLimit.Burst = v["burst"].(int)
+		rateLimit.MaxRetries = v["max_retries"].(int)
+	}
+	return rateLimit
+}
+
 func getSDKv2ProviderVars(d *schema.ResourceData) *config.Vars {
 	assumeRoleARN := ""
 	assumeRoles := d.Get("assume_role").([]any)
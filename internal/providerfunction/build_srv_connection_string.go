@@ -0,0 +1,89 @@
+package providerfunction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &BuildSRVConnectionStringFunction{}
+
+type BuildSRVConnectionStringFunction struct{}
+
+func NewBuildSRVConnectionString() function.Function {
+	return &BuildSRVConnectionStringFunction{}
+}
+
+func (f *BuildSRVConnectionStringFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_srv_connection_string"
+}
+
+func (f *BuildSRVConnectionStringFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a MongoDB SRV connection string with credentials and options",
+		MarkdownDescription: "Injects credentials, a database name and connection options into a `mongodb+srv://` connection string, such as `connection_strings.standard_srv` of `mongodbatlas_advanced_cluster`. Credentials and the database name are escaped as required by the connection string format. Options already present in the connection string are kept unless overridden, and are rendered sorted by name so the result is stable. All the values of repeated options such as `readPreferenceTags` are kept in order.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "connection_string",
+				MarkdownDescription: "MongoDB connection string using the `mongodb+srv://` scheme.",
+			},
+			function.StringParameter{
+				Name:                "username",
+				AllowNullValue:      true,
+				MarkdownDescription: "Database username. If `null` or empty, the connection string has no credentials.",
+			},
+			function.StringParameter{
+				Name:                "password",
+				AllowNullValue:      true,
+				MarkdownDescription: "Database user password. Ignored if `username` is `null` or empty.",
+			},
+			function.StringParameter{
+				Name:                "database",
+				AllowNullValue:      true,
+				MarkdownDescription: "Default authentication database. If `null`, the database of the connection string is kept.",
+			},
+			function.MapParameter{
+				Name:                "options",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+				MarkdownDescription: "Connection options, e.g. `{ retryWrites = \"true\", w = \"majority\" }`. Values override all the values of options with the same name in the connection string.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BuildSRVConnectionStringFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		raw                          string
+		username, password, database types.String
+		options                      map[string]string
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &raw, &username, &password, &database, &options))
+	if resp.Error != nil {
+		return
+	}
+	cs, err := parseConnectionString(raw)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if cs.Scheme != schemeSRV {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("connection string must use the %s scheme, got %s", schemeSRV, cs.Scheme))
+		return
+	}
+	cs.Username = username.ValueString()
+	cs.Password = ""
+	if cs.Username != "" {
+		cs.Password = password.ValueString()
+	}
+	if !database.IsNull() {
+		cs.Database = database.ValueString()
+	}
+	for key, value := range options {
+		cs.Options.Set(key, value)
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, cs.String()))
+}
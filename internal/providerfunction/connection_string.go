@@ -0,0 +1,96 @@
+package providerfunction
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	schemeStandard = "mongodb"
+	schemeSRV      = "mongodb+srv"
+)
+
+// connectionString is the parsed representation of a MongoDB connection string, see https://www.mongodb.com/docs/manual/reference/connection-string/.
+type connectionString struct {
+	Options  url.Values // Options can be repeated, e.g. readPreferenceTags, so all the values of each option are kept in order.
+	Scheme   string
+	Username string
+	Password string
+	Database string
+	Hosts    []string
+}
+
+func parseConnectionString(raw string) (*connectionString, error) {
+	scheme, rest, found := strings.Cut(raw, "://")
+	if !found {
+		return nil, errors.New("connection string must start with mongodb:// or mongodb+srv://")
+	}
+	if scheme != schemeStandard && scheme != schemeSRV {
+		return nil, fmt.Errorf("unsupported scheme %q, expected %s or %s", scheme, schemeStandard, schemeSRV)
+	}
+	cs := &connectionString{Scheme: scheme}
+
+	authority, pathAndQuery := rest, ""
+	if i := strings.IndexAny(rest, "/?"); i >= 0 {
+		authority, pathAndQuery = rest[:i], rest[i:]
+	}
+	if i := strings.LastIndex(authority, "@"); i >= 0 {
+		userInfo := authority[:i]
+		authority = authority[i+1:]
+		user, password, _ := strings.Cut(userInfo, ":")
+		var err error
+		if cs.Username, err = url.PathUnescape(user); err != nil {
+			return nil, fmt.Errorf("invalid username: %w", err)
+		}
+		if cs.Password, err = url.PathUnescape(password); err != nil {
+			return nil, fmt.Errorf("invalid password: %w", err)
+		}
+	}
+	if authority == "" {
+		return nil, errors.New("connection string must contain at least one host")
+	}
+	cs.Hosts = strings.Split(authority, ",")
+	if scheme == schemeSRV && len(cs.Hosts) != 1 {
+		return nil, fmt.Errorf("%s connection strings must contain exactly one host, got %d", schemeSRV, len(cs.Hosts))
+	}
+
+	path, query, _ := strings.Cut(strings.TrimPrefix(pathAndQuery, "/"), "?")
+	database, err := url.PathUnescape(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid database: %w", err)
+	}
+	cs.Database = database
+	if cs.Options, err = url.ParseQuery(query); err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	return cs, nil
+}
+
+// String renders the connection string with escaped credentials and options sorted by key so the result is stable across runs.
+// The values of a repeated option are rendered in their original order as their order is meaningful, e.g. in readPreferenceTags.
+func (cs *connectionString) String() string {
+	var sb strings.Builder
+	sb.WriteString(cs.Scheme)
+	sb.WriteString("://")
+	if cs.Username != "" {
+		userInfo := url.User(cs.Username)
+		if cs.Password != "" {
+			userInfo = url.UserPassword(cs.Username, cs.Password)
+		}
+		sb.WriteString(userInfo.String())
+		sb.WriteString("@")
+	}
+	sb.WriteString(strings.Join(cs.Hosts, ","))
+	if cs.Database == "" && len(cs.Options) == 0 {
+		return sb.String()
+	}
+	sb.WriteString("/")
+	sb.WriteString(url.PathEscape(cs.Database))
+	if len(cs.Options) > 0 {
+		sb.WriteString("?")
+		sb.WriteString(cs.Options.Encode())
+	}
+	return sb.String()
+}
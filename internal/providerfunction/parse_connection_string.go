@@ -0,0 +1,77 @@
+package providerfunction
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseConnectionStringFunction{}
+
+type ParseConnectionStringFunction struct{}
+
+type tfConnectionStringModel struct {
+	Options  map[string][]string `tfsdk:"options"`
+	Scheme   string              `tfsdk:"scheme"`
+	Username string              `tfsdk:"username"`
+	Password string              `tfsdk:"password"`
+	Database string              `tfsdk:"database"`
+	Hosts    []string            `tfsdk:"hosts"`
+}
+
+var connectionStringAttrTypes = map[string]attr.Type{
+	"options":  types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+	"scheme":   types.StringType,
+	"username": types.StringType,
+	"password": types.StringType,
+	"database": types.StringType,
+	"hosts":    types.ListType{ElemType: types.StringType},
+}
+
+func NewParseConnectionString() function.Function {
+	return &ParseConnectionStringFunction{}
+}
+
+func (f *ParseConnectionStringFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_connection_string"
+}
+
+func (f *ParseConnectionStringFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a MongoDB connection string",
+		MarkdownDescription: "Parses a `mongodb://` or `mongodb+srv://` connection string, such as the `connection_strings` attribute of `mongodbatlas_advanced_cluster`, into an object with `scheme`, `username`, `password`, `hosts`, `database` and `options` attributes. Credentials and the database name are returned unescaped. Each option is a list with all its values in order, as options like `readPreferenceTags` can be repeated.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "connection_string",
+				MarkdownDescription: "MongoDB connection string to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: connectionStringAttrTypes,
+		},
+	}
+}
+
+func (f *ParseConnectionStringFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var raw string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &raw))
+	if resp.Error != nil {
+		return
+	}
+	cs, err := parseConnectionString(raw)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	model := tfConnectionStringModel{
+		Options:  cs.Options,
+		Scheme:   cs.Scheme,
+		Username: cs.Username,
+		Password: cs.Password,
+		Database: cs.Database,
+		Hosts:    cs.Hosts,
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, &model))
+}
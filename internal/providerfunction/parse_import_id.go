@@ -0,0 +1,150 @@
+package providerfunction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseImportIDFunction{}
+
+type ParseImportIDFunction struct{}
+
+func NewParseImportID() function.Function {
+	return &ParseImportIDFunction{}
+}
+
+func (f *ParseImportIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_import_id"
+}
+
+func (f *ParseImportIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a resource import ID into its identifying attributes",
+		MarkdownDescription: "Splits an import ID into a map of attribute names to values using a format such as `{project_id}-{cluster_name}` or `{project_id}/{name}`, as documented in the import section of each resource. Each placeholder except the last one ends at the first occurrence of the separator that follows it, and the last placeholder takes the rest of the ID, so cluster names containing `-` are parsed as expected.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "import_id",
+				MarkdownDescription: "Import ID to parse, e.g. `5c9d0a239ccf643e6a35ddas-myCluster`.",
+			},
+			function.StringParameter{
+				Name:                "format",
+				MarkdownDescription: "Import ID format with `{attribute_name}` placeholders, e.g. `{project_id}-{cluster_name}`.",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *ParseImportIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var importID, format string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &importID, &format))
+	if resp.Error != nil {
+		return
+	}
+	tokens, err := parseImportIDFormat(format)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	values, err := matchImportID(importID, tokens)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%s, expected format: %s", err.Error(), format))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, values))
+}
+
+// importIDToken is either a literal separator or a placeholder named after the attribute it captures.
+type importIDToken struct {
+	value         string
+	isPlaceholder bool
+}
+
+func parseImportIDFormat(format string) ([]importIDToken, error) {
+	var tokens []importIDToken
+	names := map[string]bool{}
+	rest := format
+	for rest != "" {
+		start := strings.Index(rest, "{")
+		if start < 0 {
+			tokens = append(tokens, importIDToken{value: rest})
+			break
+		}
+		if start > 0 {
+			tokens = append(tokens, importIDToken{value: rest[:start]})
+		}
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return nil, errors.New("format has an unclosed placeholder")
+		}
+		name := rest[start+1 : start+end]
+		if name == "" {
+			return nil, errors.New("format has an empty placeholder")
+		}
+		if names[name] {
+			return nil, fmt.Errorf("format has a duplicated placeholder {%s}", name)
+		}
+		if len(tokens) > 0 && tokens[len(tokens)-1].isPlaceholder {
+			return nil, fmt.Errorf("placeholders must be separated, found {%s} right after {%s}", name, tokens[len(tokens)-1].value)
+		}
+		names[name] = true
+		tokens = append(tokens, importIDToken{value: name, isPlaceholder: true})
+		rest = rest[start+end+1:]
+	}
+	if len(names) == 0 {
+		return nil, errors.New("format must contain at least one placeholder such as {project_id}")
+	}
+	return tokens, nil
+}
+
+func matchImportID(importID string, tokens []importIDToken) (map[string]string, error) {
+	result := make(map[string]string)
+	rest := importID
+	for i, token := range tokens {
+		if !token.isPlaceholder {
+			if !strings.HasPrefix(rest, token.value) {
+				return nil, fmt.Errorf("import ID %q is missing separator %q", importID, token.value)
+			}
+			rest = rest[len(token.value):]
+			continue
+		}
+		value := rest
+		if i+1 < len(tokens) {
+			separator := tokens[i+1].value
+			end := strings.Index(rest, separator)
+			if end < 0 {
+				return nil, fmt.Errorf("import ID %q is missing separator %q", importID, separator)
+			}
+			value = rest[:end]
+		} else if strings.Contains(value, "/") && strings.Contains(tokenSeparators(tokens), "/") {
+			// Same as autogen.ProcessImportID, "/" separated IDs can't have extra parts, while "-" separated ones keep the rest in the last part.
+			return nil, fmt.Errorf("import ID %q has more parts than expected", importID)
+		}
+		if value == "" {
+			return nil, fmt.Errorf("import ID %q has an empty value for %s", importID, token.value)
+		}
+		result[token.value] = value
+		rest = rest[len(value):]
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("import ID %q has unexpected text %q at the end", importID, rest)
+	}
+	return result, nil
+}
+
+func tokenSeparators(tokens []importIDToken) string {
+	var sb strings.Builder
+	for _, token := range tokens {
+		if !token.isPlaceholder {
+			sb.WriteString(token.value)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,167 @@
+package accesstoken
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	// ResourceTypeName is the Terraform type name for the access token ephemeral resource.
+	ResourceTypeName = "access_token"
+	renewDataKey     = "renew_data"
+	tokenTypeBearer  = "Bearer"
+)
+
+type TokenGenerator interface {
+	GenerateToken(clientID, clientSecret, baseURL string) (*oauth2.Token, error)
+}
+
+var _ ephemeral.EphemeralResource = &ER{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ER{}
+var _ ephemeral.EphemeralResourceWithRenew = &ER{}
+
+// ER returns an access token for the Atlas Administration API using the same credentials as the provider.
+// Service Account tokens come from the token source cached by the provider, so every instance in the same
+// Terraform operation shares the provider token until it's about to expire, and they are revoked when the provider exits.
+type ER struct {
+	TokenGen TokenGenerator
+	config.ESCommon
+}
+
+type renewData struct {
+	ExpiresAt    time.Time `json:"expires_at"`
+	ClientID     string    `json:"client_id"`
+	ClientSecret string    `json:"client_secret"`
+	BaseURL      string    `json:"base_url"`
+}
+
+func New() ephemeral.EphemeralResource {
+	r := &ER{
+		ESCommon: config.ESCommon{
+			ResourceName: ResourceTypeName,
+		},
+	}
+	r.TokenGen = r
+	return r
+}
+
+func (r *ER) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = EphemeralResourceSchema(ctx)
+}
+
+func (r *ER) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model TFModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.ClientID.IsUnknown() || model.ClientSecret.IsUnknown() {
+		resp.Diagnostics.AddError("Unknown credentials",
+			"client_id and client_secret must be known at apply time to generate a token.")
+		return
+	}
+
+	model.TokenType = types.StringValue(tokenTypeBearer)
+	if accessToken := r.providerAccessToken(model); accessToken != "" {
+		// The provider access token is returned as is, it can't be renewed and its expiration isn't known.
+		model.AccessToken = types.StringValue(accessToken)
+		model.ExpiresAt = types.StringNull()
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+		return
+	}
+
+	resolver := &config.CredentialResolver{ProviderData: r.EphemeralResourceData}
+	clientID, clientSecret, baseURL, localDiags := resolver.ResolveServiceAccountCredentials(
+		model.ClientID.ValueString(),
+		model.ClientSecret.ValueString(),
+	)
+	resp.Diagnostics.Append(localDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.TokenGen.GenerateToken(clientID, clientSecret, baseURL)
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating access token", err.Error())
+		return
+	}
+
+	model.AccessToken = types.StringValue(token.AccessToken)
+	model.ExpiresAt = types.StringNull()
+	if !token.Expiry.IsZero() {
+		model.ExpiresAt = types.StringValue(token.Expiry.UTC().Format(time.RFC3339))
+		data, err := json.Marshal(renewData{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			BaseURL:      baseURL,
+			ExpiresAt:    token.Expiry,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to prepare access token renew payload", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, renewDataKey, data)...)
+		resp.RenewAt = RenewAt(token.Expiry)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
+
+// Renew is called by Terraform while the ephemeral resource is still in use when the token is about to expire.
+// It refreshes the cached token source so the provider and later opens get a valid token. Terraform doesn't allow
+// changing the result of an open ephemeral resource, so a warning is shown as the returned access_token will expire.
+func (r *ER) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	raw, diags := req.Private.GetKey(ctx, renewDataKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(raw) == 0 {
+		return
+	}
+	var data renewData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		resp.Diagnostics.AddError("Failed to read renew payload",
+			"Could not deserialize the access token renew data from private state: "+err.Error())
+		return
+	}
+	log.Printf("[DEBUG] %s Renew: refreshing access token", ResourceTypeName)
+	token, err := r.TokenGen.GenerateToken(data.ClientID, data.ClientSecret, data.BaseURL)
+	if err != nil {
+		resp.Diagnostics.AddError("Error renewing access token", err.Error())
+		return
+	}
+	if !token.Expiry.IsZero() {
+		resp.RenewAt = RenewAt(token.Expiry)
+	}
+	resp.Diagnostics.AddWarning("Access token about to expire",
+		fmt.Sprintf("The access_token returned by the %s ephemeral resource expires at %s and can't be replaced during this Terraform operation. "+
+			"Requests made with it after that time will fail to authenticate; split long operations into shorter runs.",
+			ResourceTypeName, data.ExpiresAt.UTC().Format(time.RFC3339)))
+}
+
+func (r *ER) GenerateToken(clientID, clientSecret, baseURL string) (*oauth2.Token, error) {
+	return config.ServiceAccountToken(clientID, clientSecret, baseURL, r.TerraformVersion())
+}
+
+// providerAccessToken returns the access token the provider is configured with, only used when no Service Account credentials are set in the ephemeral resource.
+func (r *ER) providerAccessToken(model TFModel) string {
+	if r.EphemeralResourceData == nil || model.ClientID.ValueString() != "" || model.ClientSecret.ValueString() != "" {
+		return ""
+	}
+	return strings.TrimSpace(r.EphemeralResourceData.AccessToken)
+}
+
+// RenewAt returns when Terraform must call Renew for a token expiring at expiry, the same time the cached token source requests a new token.
+func RenewAt(expiry time.Time) time.Time {
+	return expiry.Add(-config.SATokenExpiryBuffer)
+}
@@ -0,0 +1,127 @@
+package accesstoken_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/accesstoken"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const echoResourceName = "echo.token"
+
+var (
+	versionChecks = []tfversion.TerraformVersionCheck{
+		tfversion.SkipBelow(tfversion.Version1_10_0),
+	}
+	rfc3339Regex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`)
+)
+
+func TestAccAccessToken_providerCredentials(t *testing.T) {
+	acc.SkipIfNotSA(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		TerraformVersionChecks:   versionChecks,
+		ProtoV6ProviderFactories: acc.ProtoV6FactoriesWithEcho(),
+		Steps: []resource.TestStep{
+			{
+				Config: configProviderCredentials(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, tfjsonpath.New("data").AtMapKey("token_type"), knownvalue.StringExact("Bearer")),
+					statecheck.ExpectKnownValue(echoResourceName, tfjsonpath.New("data").AtMapKey("access_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, tfjsonpath.New("data").AtMapKey("expires_at"), knownvalue.StringRegexp(rfc3339Regex)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAccessToken_explicitCredentials(t *testing.T) {
+	acc.SkipIfNotSA(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		TerraformVersionChecks:   versionChecks,
+		ProtoV6ProviderFactories: acc.ProtoV6FactoriesWithEcho(),
+		Steps: []resource.TestStep{
+			{
+				Config: configExplicitCredentials(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, tfjsonpath.New("data").AtMapKey("token_type"), knownvalue.StringExact("Bearer")),
+					statecheck.ExpectKnownValue(echoResourceName, tfjsonpath.New("data").AtMapKey("access_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, tfjsonpath.New("data").AtMapKey("expires_at"), knownvalue.StringRegexp(rfc3339Regex)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAccessToken_partialResourceCredentials(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		TerraformVersionChecks:   versionChecks,
+		ProtoV6ProviderFactories: acc.ProtoV6FactoriesWithEcho(),
+		Steps: []resource.TestStep{
+			{
+				Config:      configPartialCredentials(),
+				ExpectError: regexp.MustCompile(config.ErrPartialCreds),
+			},
+		},
+	})
+}
+
+func TestRenewAt(t *testing.T) {
+	expiry := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, expiry.Add(-config.SATokenExpiryBuffer), accesstoken.RenewAt(expiry))
+}
+
+func configProviderCredentials() string {
+	return `
+ephemeral "mongodbatlas_access_token" "test" {}
+
+provider "echo" {
+  data = ephemeral.mongodbatlas_access_token.test
+}
+
+resource "echo" "token" {}
+`
+}
+
+func configExplicitCredentials() string {
+	return fmt.Sprintf(`
+ephemeral "mongodbatlas_access_token" "test" {
+  client_id     = %q
+  client_secret = %q
+}
+
+provider "echo" {
+  data = ephemeral.mongodbatlas_access_token.test
+}
+
+resource "echo" "token" {}
+`, os.Getenv("MONGODB_ATLAS_CLIENT_ID"), os.Getenv("MONGODB_ATLAS_CLIENT_SECRET"))
+}
+
+func configPartialCredentials() string {
+	return `
+ephemeral "mongodbatlas_access_token" "test" {
+  client_id = "mdb_sa_id_000000000000000000000000"
+}
+
+provider "echo" {
+  data = ephemeral.mongodbatlas_access_token.test
+}
+
+resource "echo" "token" {}
+`
+}
@@ -0,0 +1,87 @@
+package advancedcluster
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	pauseActionName  = "cluster_pause"
+	resumeActionName = "cluster_resume"
+	operationPause   = "pause"
+	operationResume  = "resume"
+)
+
+var _ action.ActionWithConfigure = &pauseAction{}
+
+func PauseAction() action.Action {
+	return &pauseAction{
+		ActionCommon: config.ActionCommon{
+			ActionName: pauseActionName,
+		},
+		paused: true,
+	}
+}
+
+func ResumeAction() action.Action {
+	return &pauseAction{
+		ActionCommon: config.ActionCommon{
+			ActionName: resumeActionName,
+		},
+		paused: false,
+	}
+}
+
+// pauseAction pauses or resumes a dedicated cluster depending on paused.
+type pauseAction struct {
+	config.ActionCommon
+	paused bool
+}
+
+func (a *pauseAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	description := "Pauses a dedicated cluster and waits until the cluster is `IDLE` again."
+	if !a.paused {
+		description = "Resumes a paused dedicated cluster and waits until the cluster is `IDLE` again."
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+			},
+			"cluster_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Human-readable label that identifies the cluster.",
+			},
+		},
+	}
+}
+
+func (a *pauseAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model TFClusterActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	waitParams := &ClusterWaitParams{
+		ProjectID:   model.ProjectID.ValueString(),
+		ClusterName: model.ClusterName.ValueString(),
+		Timeout:     constant.DefaultTimeout,
+	}
+	patchReq, operation, progress := &pauseRequest, operationPause, "Waiting for cluster %s to pause"
+	if !a.paused {
+		patchReq, operation, progress = &resumeRequest, operationResume, "Waiting for cluster %s to resume"
+	}
+	if _, _, err := a.Client.AtlasV2.ClustersAPI.UpdateCluster(ctx, waitParams.ProjectID, waitParams.ClusterName, patchReq).Execute(); err != nil {
+		addErrorDiag(&resp.Diagnostics, operation, defaultAPIErrorDetails(waitParams.ClusterName, err))
+		return
+	}
+	config.SendProgress(resp, progress, waitParams.ClusterName)
+	_ = AwaitChanges(ctx, a.Client, waitParams, operation, &resp.Diagnostics)
+}
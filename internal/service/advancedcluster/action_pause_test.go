@@ -0,0 +1,78 @@
+package advancedcluster_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/atlas-sdk/v20250312023/mockadmin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/advancedcluster"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/unit"
+)
+
+func TestPauseActionInvoke(t *testing.T) {
+	shortenRetries(t)
+	const clusterName = "cluster"
+	testCases := map[string]struct {
+		action           action.Action
+		updateErr        error
+		getClusterErr    error
+		expectedError    string
+		expectedProgress string
+		paused           bool
+	}{
+		"pause and wait until idle": {
+			action:           advancedcluster.PauseAction(),
+			paused:           true,
+			expectedProgress: "Waiting for cluster cluster to pause",
+		},
+		"resume and wait until idle": {
+			action:           advancedcluster.ResumeAction(),
+			paused:           false,
+			expectedProgress: "Waiting for cluster cluster to resume",
+		},
+		"pause error": {
+			action:        advancedcluster.PauseAction(),
+			paused:        true,
+			updateErr:     errGeneric,
+			expectedError: "Error in pause",
+		},
+		"resume wait error": {
+			action:        advancedcluster.ResumeAction(),
+			paused:        false,
+			getClusterErr: errGeneric,
+			expectedError: "Error in resume",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := mockadmin.NewClustersAPI(t)
+			m.EXPECT().UpdateCluster(mock.Anything, dummyProjectID, clusterName, &admin.ClusterDescription20240805{Paused: new(tc.paused)}).Return(admin.UpdateClusterApiRequest{ApiService: m}).Once()
+			m.EXPECT().UpdateClusterExecute(mock.Anything).Return(nil, nil, tc.updateErr).Once()
+			if tc.updateErr == nil {
+				m.EXPECT().GetCluster(mock.Anything, dummyProjectID, clusterName).Return(admin.GetClusterApiRequest{ApiService: m})
+				m.EXPECT().GetClusterExecute(mock.Anything).Return(&admin.ClusterDescription20240805{StateName: conversion.StringPtr("UPDATING")}, nil, nil).Once()
+				if tc.getClusterErr != nil {
+					m.EXPECT().GetClusterExecute(mock.Anything).Return(nil, nil, tc.getClusterErr).Once()
+				} else {
+					m.EXPECT().GetClusterExecute(mock.Anything).Return(&admin.ClusterDescription20240805{StateName: conversion.StringPtr("IDLE"), Paused: new(tc.paused)}, nil, nil).Once()
+				}
+			}
+			client := &admin.APIClient{ClustersAPI: m}
+			attributes := map[string]any{"project_id": dummyProjectID, "cluster_name": clusterName}
+			resp, progress := unit.InvokeAction(t, tc.action, client, attributes)
+			if tc.expectedError != "" {
+				if assert.True(t, resp.Diagnostics.HasError()) {
+					assert.Equal(t, tc.expectedError, resp.Diagnostics.Errors()[0].Summary())
+				}
+				return
+			}
+			unit.AssertDiagsOK(t, resp.Diagnostics)
+			assert.Equal(t, []string{tc.expectedProgress}, progress)
+		})
+	}
+}
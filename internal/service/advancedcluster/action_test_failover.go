@@ -0,0 +1,72 @@
+package advancedcluster
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	testFailoverActionName = "cluster_test_failover"
+	operationTestFailover  = "test failover"
+)
+
+var _ action.ActionWithConfigure = &testFailoverAction{}
+
+func TestFailoverAction() action.Action {
+	return &testFailoverAction{
+		ActionCommon: config.ActionCommon{
+			ActionName: testFailoverActionName,
+		},
+	}
+}
+
+type testFailoverAction struct {
+	config.ActionCommon
+}
+
+// TFClusterActionModel is the config of the actions that operate on a cluster.
+type TFClusterActionModel struct {
+	ProjectID   types.String `tfsdk:"project_id"`
+	ClusterName types.String `tfsdk:"cluster_name"`
+}
+
+func (a *testFailoverAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Starts a failover test for the primary node of every shard of a dedicated cluster and waits until the cluster is `IDLE` again.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+			},
+			"cluster_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Human-readable label that identifies the cluster.",
+			},
+		},
+	}
+}
+
+func (a *testFailoverAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model TFClusterActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	waitParams := &ClusterWaitParams{
+		ProjectID:   model.ProjectID.ValueString(),
+		ClusterName: model.ClusterName.ValueString(),
+		Timeout:     constant.DefaultTimeout,
+	}
+	if _, err := a.Client.AtlasV2.ClustersAPI.TestFailover(ctx, waitParams.ProjectID, waitParams.ClusterName).Execute(); err != nil {
+		addErrorDiag(&resp.Diagnostics, operationTestFailover, defaultAPIErrorDetails(waitParams.ClusterName, err))
+		return
+	}
+	config.SendProgress(resp, "Waiting for cluster %s to finish the failover test", waitParams.ClusterName)
+	_ = AwaitChanges(ctx, a.Client, waitParams, operationTestFailover, &resp.Diagnostics)
+}
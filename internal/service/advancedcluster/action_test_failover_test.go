@@ -0,0 +1,74 @@
+package advancedcluster_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/atlas-sdk/v20250312023/mockadmin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/advancedcluster"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/unit"
+)
+
+func TestTestFailoverActionInvoke(t *testing.T) {
+	shortenRetries(t)
+	const clusterName = "cluster"
+	testCases := map[string]struct {
+		failoverErr   error
+		getClusterErr error
+		states        []string
+		expectedError bool
+	}{
+		"failover and wait until idle": {
+			states: []string{"UPDATING", "IDLE"},
+		},
+		"failover error": {
+			failoverErr:   errGeneric,
+			expectedError: true,
+		},
+		"wait error": {
+			getClusterErr: errGeneric,
+			expectedError: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := mockadmin.NewClustersAPI(t)
+			m.EXPECT().TestFailover(mock.Anything, dummyProjectID, clusterName).Return(admin.TestFailoverApiRequest{ApiService: m}).Once()
+			m.EXPECT().TestFailoverExecute(mock.Anything).Return(nil, tc.failoverErr).Once()
+			if tc.failoverErr == nil {
+				m.EXPECT().GetCluster(mock.Anything, dummyProjectID, clusterName).Return(admin.GetClusterApiRequest{ApiService: m})
+				for _, state := range tc.states {
+					m.EXPECT().GetClusterExecute(mock.Anything).Return(&admin.ClusterDescription20240805{StateName: conversion.StringPtr(state)}, nil, nil).Once()
+				}
+				if tc.getClusterErr != nil {
+					m.EXPECT().GetClusterExecute(mock.Anything).Return(nil, nil, tc.getClusterErr).Once()
+				}
+			}
+			client := &admin.APIClient{ClustersAPI: m}
+			attributes := map[string]any{"project_id": dummyProjectID, "cluster_name": clusterName}
+			resp, progress := unit.InvokeAction(t, advancedcluster.TestFailoverAction(), client, attributes)
+			if tc.expectedError {
+				if assert.True(t, resp.Diagnostics.HasError()) {
+					assert.Equal(t, "Error in test failover", resp.Diagnostics.Errors()[0].Summary())
+				}
+				return
+			}
+			unit.AssertDiagsOK(t, resp.Diagnostics)
+			assert.Equal(t, []string{"Waiting for cluster cluster to finish the failover test"}, progress)
+		})
+	}
+}
+
+func shortenRetries(t *testing.T) {
+	t.Helper()
+	minTimeout, delay, pollInterval := advancedcluster.RetryMinTimeout, advancedcluster.RetryDelay, advancedcluster.RetryPollInterval
+	advancedcluster.RetryMinTimeout, advancedcluster.RetryDelay, advancedcluster.RetryPollInterval = time.Millisecond, time.Millisecond, time.Millisecond
+	t.Cleanup(func() {
+		advancedcluster.RetryMinTimeout, advancedcluster.RetryDelay, advancedcluster.RetryPollInterval = minTimeout, delay, pollInterval
+	})
+}
@@ -131,6 +131,10 @@ func GetClusterDetails(ctx context.Context, diags *diag.Diagnostics, projectID,
 		if validate.StatusNotFound(resp) || admin.IsErrorCode(err, ErrorCodeClusterNotFound) {
 			return nil, nil
 		}
+		if admin.IsErrorCode(err, errorCodeServerlessInClusterAPI) {
+			diags.AddError(errorReadResource, fmt.Sprintf(errorServerlessNotMigrated, clusterName))
+			return nil, nil
+		}
 		if isFlex = admin.IsErrorCode(err, "CANNOT_USE_FLEX_CLUSTER_IN_CLUSTER_API"); !isFlex {
 			diags.AddError(errorReadResource, defaultAPIErrorDetails(clusterName, err))
 			return nil, nil
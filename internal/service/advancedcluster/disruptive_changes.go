@@ -0,0 +1,190 @@
+package advancedcluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Impacts of the changes that disrupt a cluster, changes without impact (no-op) like increasing disk_size_gb are not reported.
+const (
+	impactRollingRestart = "ROLLING_RESTART"
+	impactResync         = "RESYNC"
+	impactDowntimeRisk   = "DOWNTIME_RISK"
+)
+
+var disruptiveImpacts = []string{impactRollingRestart, impactResync, impactDowntimeRisk}
+
+type disruptiveChange struct {
+	Attribute string
+	Impact    string
+	Detail    string
+}
+
+// warnDisruptiveChanges adds a warning with the planned changes that restart nodes, resync data or risk downtime.
+// If require_ack_for_disruptive_changes is true, it fails the plan when their impacts are not in acknowledged_disruptive_changes.
+func warnDisruptiveChanges(ctx context.Context, diags *diag.Diagnostics, state, plan *TFModel) {
+	changes := findDisruptiveChanges(ctx, diags, state, plan)
+	if diags.HasError() || len(changes) == 0 {
+		return
+	}
+	lines := make([]string, len(changes))
+	var impacts []string
+	for i, change := range changes {
+		lines[i] = fmt.Sprintf("- %s (%s): %s", change.Attribute, change.Impact, change.Detail)
+		if !slices.Contains(impacts, change.Impact) {
+			impacts = append(impacts, change.Impact)
+		}
+	}
+	clusterName := plan.Name.ValueString()
+	details := strings.Join(lines, "\n")
+	diags.AddWarning(fmt.Sprintf("Disruptive changes planned for cluster %s", clusterName), details)
+	if !plan.RequireAckForDisruptiveChanges.ValueBool() {
+		return
+	}
+	var acknowledged []string
+	diags.Append(plan.AcknowledgedDisruptiveChanges.ElementsAs(ctx, &acknowledged, false)...)
+	var missing []string
+	for _, impact := range impacts {
+		if !slices.Contains(acknowledged, impact) {
+			missing = append(missing, impact)
+		}
+	}
+	if len(missing) > 0 {
+		diags.AddError(fmt.Sprintf("Disruptive changes not acknowledged for cluster %s", clusterName),
+			fmt.Sprintf("require_ack_for_disruptive_changes is true and the plan has changes with impact %s that are not in acknowledged_disruptive_changes:\n%s\n\n"+
+				"Add the impacts to acknowledged_disruptive_changes to apply the changes.", strings.Join(missing, ", "), details))
+	}
+}
+
+// findDisruptiveChanges classifies the planned changes of a cluster by their impact. Values that are unknown in the plan are ignored,
+// e.g. instance_size when auto-scaling is enabled.
+func findDisruptiveChanges(ctx context.Context, diags *diag.Diagnostics, state, plan *TFModel) []disruptiveChange {
+	var changes []disruptiveChange
+	add := func(attribute, impact, detail string) {
+		changes = append(changes, disruptiveChange{Attribute: attribute, Impact: impact, Detail: detail})
+	}
+	if isKnown(plan.Paused) && plan.Paused.ValueBool() && !state.Paused.ValueBool() {
+		add("paused", impactDowntimeRisk, "the cluster is unavailable until it's resumed")
+	}
+	if isKnown(plan.MongoDBMajorVersion) && isKnown(state.MongoDBMajorVersion) &&
+		FormatMongoDBMajorVersion(plan.MongoDBMajorVersion.ValueString()) != FormatMongoDBMajorVersion(state.MongoDBMajorVersion.ValueString()) {
+		add("mongo_db_major_version", impactRollingRestart, fmt.Sprintf("%s -> %s, each node is restarted with the new version one at a time and the primary steps down for an election",
+			state.MongoDBMajorVersion.ValueString(), plan.MongoDBMajorVersion.ValueString()))
+	}
+	if isKnown(plan.ClusterType) && isKnown(state.ClusterType) && plan.ClusterType.ValueString() != state.ClusterType.ValueString() {
+		add("cluster_type", impactRollingRestart, fmt.Sprintf("%s -> %s, nodes are restarted to change the cluster topology", state.ClusterType.ValueString(), plan.ClusterType.ValueString()))
+	}
+	if from, to := plannedTierChange(ctx, diags, state, plan); from != "" {
+		// The rest of replication_specs changes are part of the tier upgrade.
+		add("replication_specs", impactDowntimeRisk, fmt.Sprintf("%s -> %s, the cluster is upgraded in place to the new tier and is unavailable for several minutes while the data is migrated", from, to))
+		return changes
+	}
+	stateRepSpecs := TFModelList[TFReplicationSpecsModel](ctx, diags, state.ReplicationSpecs)
+	planRepSpecs := TFModelList[TFReplicationSpecsModel](ctx, diags, plan.ReplicationSpecs)
+	if diags.HasError() {
+		return nil
+	}
+	for i := len(stateRepSpecs); i < len(planRepSpecs); i++ {
+		add(fmt.Sprintf("replication_specs[%d]", i), impactResync, "the nodes of the new shard perform an initial sync and chunks are migrated to the shard")
+	}
+	for i := len(planRepSpecs); i < len(stateRepSpecs); i++ {
+		add(fmt.Sprintf("replication_specs[%d]", i), impactResync, "the data of the removed shard is migrated to the remaining shards")
+	}
+	for i := range minLen(planRepSpecs, stateRepSpecs) {
+		attribute := fmt.Sprintf("replication_specs[%d].region_configs", i)
+		stateRegionConfigs := TFModelList[TFRegionConfigsModel](ctx, diags, stateRepSpecs[i].RegionConfigs)
+		planRegionConfigs := TFModelList[TFRegionConfigsModel](ctx, diags, planRepSpecs[i].RegionConfigs)
+		if diags.HasError() {
+			return nil
+		}
+		statePrimary, planPrimary := highestPriorityRegion(stateRegionConfigs), highestPriorityRegion(planRegionConfigs)
+		if statePrimary != "" && planPrimary != "" && statePrimary != planPrimary {
+			add(attribute, impactDowntimeRisk, fmt.Sprintf("the highest priority region changes from %s to %s, the primary steps down and writes are unavailable until the election completes", statePrimary, planPrimary))
+		}
+		for j := len(stateRegionConfigs); j < len(planRegionConfigs); j++ {
+			add(fmt.Sprintf("%s[%d]", attribute, j), impactResync, "the nodes of the new region perform an initial sync")
+		}
+		for j := range minLen(planRegionConfigs, stateRegionConfigs) {
+			regionAttribute := fmt.Sprintf("%s[%d]", attribute, j)
+			stateRegion, planRegion := stateRegionConfigs[j], planRegionConfigs[j]
+			if changedString(stateRegion.ProviderName, planRegion.ProviderName) || changedString(stateRegion.RegionName, planRegion.RegionName) {
+				add(regionAttribute, impactResync, fmt.Sprintf("%s -> %s, nodes are replaced in the new region and perform an initial sync",
+					regionLabel(&stateRegion), regionLabel(&planRegion)))
+				continue
+			}
+			for _, specs := range []struct {
+				name        string
+				state, plan types.Object
+			}{
+				{"electable_specs", stateRegion.ElectableSpecs, planRegion.ElectableSpecs},
+				{"read_only_specs", stateRegion.ReadOnlySpecs, planRegion.ReadOnlySpecs},
+				{"analytics_specs", stateRegion.AnalyticsSpecs, planRegion.AnalyticsSpecs},
+			} {
+				stateSpecs, planSpecs := TFModelObject[TFSpecsModel](ctx, specs.state), TFModelObject[TFSpecsModel](ctx, specs.plan)
+				if stateSpecs == nil || planSpecs == nil {
+					continue
+				}
+				changes = append(changes, specsChanges(fmt.Sprintf("%s.%s", regionAttribute, specs.name), stateSpecs, planSpecs)...)
+			}
+		}
+	}
+	return changes
+}
+
+func specsChanges(attribute string, state, plan *TFSpecsModel) []disruptiveChange {
+	var changes []disruptiveChange
+	if changedString(state.InstanceSize, plan.InstanceSize) {
+		changes = append(changes, disruptiveChange{
+			Attribute: attribute + ".instance_size",
+			Impact:    impactRollingRestart,
+			Detail:    fmt.Sprintf("%s -> %s, each node is restarted one at a time and the primary steps down for an election", state.InstanceSize.ValueString(), plan.InstanceSize.ValueString()),
+		})
+	}
+	if isKnown(state.DiskSizeGb) && isKnown(plan.DiskSizeGb) && plan.DiskSizeGb.ValueFloat64() < state.DiskSizeGb.ValueFloat64() {
+		changes = append(changes, disruptiveChange{
+			Attribute: attribute + ".disk_size_gb",
+			Impact:    impactResync,
+			Detail:    fmt.Sprintf("%v -> %v, decreasing storage replaces the disk of each node, which performs an initial sync", state.DiskSizeGb.ValueFloat64(), plan.DiskSizeGb.ValueFloat64()),
+		})
+	}
+	if isKnown(state.NodeCount) && isKnown(plan.NodeCount) && plan.NodeCount.ValueInt64() > state.NodeCount.ValueInt64() {
+		changes = append(changes, disruptiveChange{
+			Attribute: attribute + ".node_count",
+			Impact:    impactResync,
+			Detail:    fmt.Sprintf("%d -> %d, the new nodes perform an initial sync", state.NodeCount.ValueInt64(), plan.NodeCount.ValueInt64()),
+		})
+	}
+	return changes
+}
+
+// highestPriorityRegion returns the region where the primary is elected, empty if a priority is unknown.
+func highestPriorityRegion(regionConfigs []TFRegionConfigsModel) string {
+	var (
+		region   string
+		priority int64 = -1
+	)
+	for i := range regionConfigs {
+		if !isKnown(regionConfigs[i].Priority) {
+			return ""
+		}
+		if regionConfigs[i].Priority.ValueInt64() > priority {
+			priority = regionConfigs[i].Priority.ValueInt64()
+			region = regionLabel(&regionConfigs[i])
+		}
+	}
+	return region
+}
+
+func regionLabel(regionConfig *TFRegionConfigsModel) string {
+	return fmt.Sprintf("%s/%s", regionConfig.ProviderName.ValueString(), regionConfig.RegionName.ValueString())
+}
+
+// changedString returns true if both values are known and different.
+func changedString(state, plan types.String) bool {
+	return isKnown(state) && isKnown(plan) && state.ValueString() != plan.ValueString()
+}
@@ -0,0 +1,246 @@
+package advancedcluster_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/advancedcluster"
+)
+
+type regionTest struct {
+	instanceSize types.String
+	provider     string
+	name         string
+	priority     int64
+	nodeCount    int64
+	diskSizeGb   float64
+}
+
+type clusterTest struct {
+	majorVersion string
+	clusterType  string
+	shards       [][]regionTest
+	paused       bool
+}
+
+func region(name string, priority int64) regionTest {
+	return regionTest{provider: "AWS", name: name, priority: priority, instanceSize: types.StringValue("M10"), nodeCount: 3, diskSizeGb: 10}
+}
+
+func baseCluster() clusterTest {
+	return clusterTest{
+		majorVersion: "7.0",
+		clusterType:  "REPLICASET",
+		shards:       [][]regionTest{{region("US_EAST_1", 7), region("US_WEST_2", 6)}},
+	}
+}
+
+func newModel(t *testing.T, cluster *clusterTest) *advancedcluster.TFModel {
+	t.Helper()
+	ctx := t.Context()
+	nullObject := func(name string) types.Object {
+		return types.ObjectNull(advancedcluster.RegionConfigsObjTypeForTest.AttrTypes[name].(types.ObjectType).AttrTypes)
+	}
+	repSpecs := make([]advancedcluster.TFReplicationSpecsModel, len(cluster.shards))
+	for i, shard := range cluster.shards {
+		regionConfigs := make([]advancedcluster.TFRegionConfigsModel, len(shard))
+		for j, r := range shard {
+			electableSpecs, diags := types.ObjectValueFrom(ctx, advancedcluster.SpecsObjTypeForTest.AttrTypes, advancedcluster.TFSpecsModel{
+				InstanceSize:  r.instanceSize,
+				NodeCount:     types.Int64Value(r.nodeCount),
+				DiskSizeGb:    types.Float64Value(r.diskSizeGb),
+				DiskIops:      types.Int64Null(),
+				EbsVolumeType: types.StringNull(),
+			})
+			require.False(t, diags.HasError(), diags)
+			regionConfigs[j] = advancedcluster.TFRegionConfigsModel{
+				ProviderName:         types.StringValue(r.provider),
+				RegionName:           types.StringValue(r.name),
+				Priority:             types.Int64Value(r.priority),
+				BackingProviderName:  types.StringNull(),
+				ElectableSpecs:       electableSpecs,
+				ReadOnlySpecs:        nullObject("read_only_specs"),
+				AnalyticsSpecs:       nullObject("analytics_specs"),
+				AutoScaling:          nullObject("auto_scaling"),
+				AnalyticsAutoScaling: nullObject("analytics_auto_scaling"),
+			}
+		}
+		regionConfigsList, diags := types.ListValueFrom(ctx, advancedcluster.RegionConfigsObjTypeForTest, regionConfigs)
+		require.False(t, diags.HasError(), diags)
+		repSpecs[i] = advancedcluster.TFReplicationSpecsModel{
+			RegionConfigs: regionConfigsList,
+			ContainerId:   types.MapNull(types.StringType),
+			ExternalId:    types.StringNull(),
+			ZoneId:        types.StringNull(),
+			ZoneName:      types.StringNull(),
+		}
+	}
+	repSpecsList, diags := types.ListValueFrom(ctx, advancedcluster.ReplicationSpecsObjTypeForTest, repSpecs)
+	require.False(t, diags.HasError(), diags)
+	return &advancedcluster.TFModel{
+		Name:                          types.StringValue("test"),
+		MongoDBMajorVersion:           types.StringValue(cluster.majorVersion),
+		ClusterType:                   types.StringValue(cluster.clusterType),
+		Paused:                        types.BoolValue(cluster.paused),
+		ReplicationSpecs:              repSpecsList,
+		AcknowledgedDisruptiveChanges: types.SetNull(types.StringType),
+	}
+}
+
+func TestFindDisruptiveChanges(t *testing.T) {
+	testCases := map[string]struct {
+		modify   func(c *clusterTest)
+		expected map[string]string
+	}{
+		"no changes": {
+			modify:   func(c *clusterTest) {},
+			expected: map[string]string{},
+		},
+		"disk increase and node removal are no-op": {
+			modify: func(c *clusterTest) {
+				c.shards[0][0].diskSizeGb = 20
+				c.shards[0][1].nodeCount = 2
+			},
+			expected: map[string]string{},
+		},
+		"same major version in other format": {
+			modify:   func(c *clusterTest) { c.majorVersion = "7" },
+			expected: map[string]string{},
+		},
+		"unknown instance size is ignored": {
+			modify:   func(c *clusterTest) { c.shards[0][0].instanceSize = types.StringUnknown() },
+			expected: map[string]string{},
+		},
+		"instance size": {
+			modify: func(c *clusterTest) { c.shards[0][1].instanceSize = types.StringValue("M30") },
+			expected: map[string]string{
+				"replication_specs[0].region_configs[1].electable_specs.instance_size": "ROLLING_RESTART",
+			},
+		},
+		"major version": {
+			modify:   func(c *clusterTest) { c.majorVersion = "8" },
+			expected: map[string]string{"mongo_db_major_version": "ROLLING_RESTART"},
+		},
+		"cluster type": {
+			modify:   func(c *clusterTest) { c.clusterType = "SHARDED" },
+			expected: map[string]string{"cluster_type": "ROLLING_RESTART"},
+		},
+		"pause": {
+			modify:   func(c *clusterTest) { c.paused = true },
+			expected: map[string]string{"paused": "DOWNTIME_RISK"},
+		},
+		"highest priority region changes": {
+			modify: func(c *clusterTest) {
+				c.shards[0][0].priority = 6
+				c.shards[0][1].priority = 7
+			},
+			expected: map[string]string{"replication_specs[0].region_configs": "DOWNTIME_RISK"},
+		},
+		"lower priority changes are no-op": {
+			modify: func(c *clusterTest) {
+				c.shards[0][1].priority = 5
+			},
+			expected: map[string]string{},
+		},
+		"new region": {
+			modify: func(c *clusterTest) { c.shards[0] = append(c.shards[0], region("EU_WEST_1", 5)) },
+			expected: map[string]string{
+				"replication_specs[0].region_configs[2]": "RESYNC",
+			},
+		},
+		"replaced region": {
+			modify: func(c *clusterTest) { c.shards[0][1] = region("EU_WEST_1", 6) },
+			expected: map[string]string{
+				"replication_specs[0].region_configs[1]": "RESYNC",
+			},
+		},
+		"node count increase and disk decrease": {
+			modify: func(c *clusterTest) {
+				c.shards[0][0].nodeCount = 5
+				c.shards[0][0].diskSizeGb = 5
+			},
+			expected: map[string]string{
+				"replication_specs[0].region_configs[0].electable_specs.node_count":   "RESYNC",
+				"replication_specs[0].region_configs[0].electable_specs.disk_size_gb": "RESYNC",
+			},
+		},
+		"tier change is reported once": {
+			modify: func(c *clusterTest) {
+				c.shards[0] = []regionTest{region("US_EAST_1", 7)}
+				c.shards[0][0].provider = "TENANT"
+				c.shards[0][0].instanceSize = types.StringValue("M0")
+			},
+			expected: map[string]string{"replication_specs": "DOWNTIME_RISK"},
+		},
+		"new shard": {
+			modify: func(c *clusterTest) {
+				c.clusterType = "SHARDED"
+				c.shards = append(c.shards, []regionTest{region("US_EAST_1", 7)})
+			},
+			expected: map[string]string{
+				"cluster_type":         "ROLLING_RESTART",
+				"replication_specs[1]": "RESYNC",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			state := baseCluster()
+			plan := baseCluster()
+			tc.modify(&plan)
+			diags := &diag.Diagnostics{}
+			changes := advancedcluster.FindDisruptiveChangesForTest(t.Context(), diags, newModel(t, &state), newModel(t, &plan))
+			require.False(t, diags.HasError(), diags)
+			actual := make(map[string]string)
+			for _, change := range changes {
+				actual[change.Attribute] = change.Impact
+				assert.NotEmpty(t, change.Detail)
+			}
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestWarnDisruptiveChanges(t *testing.T) {
+	testCases := map[string]struct {
+		acknowledged []string
+		requireAck   bool
+		expectError  bool
+	}{
+		"warning only by default": {
+			requireAck: false,
+		},
+		"error if not acknowledged": {
+			requireAck:   true,
+			acknowledged: []string{"RESYNC"},
+			expectError:  true,
+		},
+		"acknowledged": {
+			requireAck:   true,
+			acknowledged: []string{"ROLLING_RESTART", "DOWNTIME_RISK"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			state := baseCluster()
+			plan := baseCluster()
+			plan.majorVersion = "8.0"
+			plan.paused = true
+			planModel := newModel(t, &plan)
+			planModel.RequireAckForDisruptiveChanges = types.BoolValue(tc.requireAck)
+			if tc.acknowledged != nil {
+				acknowledged, diags := types.SetValueFrom(t.Context(), types.StringType, tc.acknowledged)
+				require.False(t, diags.HasError(), diags)
+				planModel.AcknowledgedDisruptiveChanges = acknowledged
+			}
+			diags := &diag.Diagnostics{}
+			advancedcluster.WarnDisruptiveChangesForTest(t.Context(), diags, newModel(t, &state), planModel)
+			assert.Equal(t, 1, diags.WarningsCount())
+			assert.Equal(t, tc.expectError, diags.HasError())
+		})
+	}
+}
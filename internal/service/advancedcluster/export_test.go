@@ -0,0 +1,32 @@
+package advancedcluster
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+var (
+	FindDisruptiveChangesForTest    = findDisruptiveChanges
+	WarnDisruptiveChangesForTest    = warnDisruptiveChanges
+	MajorVersionUpgradePathForTest  = majorVersionUpgradePath
+	CheckMajorVersionUpgradeForTest = checkMajorVersionUpgrade
+	CheckTierMigrationForTest       = checkTierMigration
+	ReplicationSpecsObjTypeForTest  = replicationSpecsObjType
+	RegionConfigsObjTypeForTest     = regionConfigsObjType
+	SpecsObjTypeForTest             = specsObjType
+)
+
+func ApplyMajorVersionUpgradeStepsForTest(ctx context.Context, api admin.ClustersAPI, diags *diag.Diagnostics, respState stateSetter, state, plan *TFModel, waitParams *ClusterWaitParams) *admin.ClusterDescription20240805 {
+	r := &rs{RSCommon: config.RSCommon{Client: &config.MongoDBClient{AtlasV2: &admin.APIClient{ClustersAPI: api}}}}
+	return r.applyMajorVersionUpgradeSteps(ctx, diags, respState, state, plan, waitParams)
+}
+
+func MoveStateForTest(ctx context.Context, api admin.ClustersAPI, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	r := &rs{RSCommon: config.RSCommon{Client: &config.MongoDBClient{AtlasV2: &admin.APIClient{ClustersAPI: api}}}}
+	r.MoveState(ctx)[0].StateMover(ctx, req, resp)
+}
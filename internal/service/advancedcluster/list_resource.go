@@ -0,0 +1,99 @@
+package advancedcluster
+
+import (
+	"context"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/flexcluster"
+)
+
+var _ list.ListResourceWithConfigure = &listRS{}
+
+func ListResource() list.ListResource {
+	return &listRS{
+		LSCommon: config.LSCommon{
+			ResourceName: resourceName,
+		},
+	}
+}
+
+type listRS struct {
+	config.LSCommon
+}
+
+type TFListModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+func (l *listRS) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the project whose clusters are listed.",
+			},
+		},
+	}
+}
+
+// List finds the dedicated, tenant and flex clusters in the project.
+func (l *listRS) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var model TFListModel
+	diags := req.Config.Get(ctx, &model)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	projectID := model.ProjectID.ValueString()
+	items := l.listClusters(ctx, &diags, projectID)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	l.StreamListItems(ctx, req, stream, Resource().(config.ImplementedResource), items)
+}
+
+func (l *listRS) listClusters(ctx context.Context, diags *diag.Diagnostics, projectID string) []config.ListItem {
+	params := admin.ListClustersApiParams{
+		GroupId: projectID,
+	}
+	clusters, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.ClusterDescription20240805], *http.Response, error) {
+		return l.Client.AtlasV2.ClustersAPI.ListClustersWithParams(ctx, &params).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		addListError(diags, projectID, err)
+		return nil
+	}
+	flexClusters, err := flexcluster.ListFlexClusters(ctx, projectID, l.Client.AtlasV2.FlexClustersAPI)
+	if err != nil {
+		addListError(diags, projectID, err)
+		return nil
+	}
+	items := make([]config.ListItem, 0, len(clusters)+len(*flexClusters))
+	for i := range clusters {
+		items = append(items, newClusterListItem(projectID, clusters[i].GetName()))
+	}
+	for i := range *flexClusters {
+		items = append(items, newClusterListItem(projectID, (*flexClusters)[i].GetName()))
+	}
+	return items
+}
+
+func newClusterListItem(projectID, clusterName string) config.ListItem {
+	return config.ListItem{
+		DisplayName: clusterName,
+		Identity: map[string]string{
+			"project_id": projectID,
+			"name":       clusterName,
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package advancedcluster_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/atlas-sdk/v20250312023/mockadmin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/advancedcluster"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/unit"
+)
+
+func TestListResource(t *testing.T) {
+	clustersAPI := mockadmin.NewClustersAPI(t)
+	clustersAPI.EXPECT().ListClustersWithParams(mock.Anything, &admin.ListClustersApiParams{GroupId: dummyProjectID}).Return(admin.ListClustersApiRequest{ApiService: clustersAPI}).Times(2)
+	clustersAPI.EXPECT().ListClustersExecute(mock.Anything).Return(&admin.PaginatedClusterDescription20240805{
+		Results:    []admin.ClusterDescription20240805{{Name: new("cluster1")}},
+		TotalCount: conversion.IntPtr(2),
+	}, nil, nil).Once()
+	clustersAPI.EXPECT().ListClustersExecute(mock.Anything).Return(&admin.PaginatedClusterDescription20240805{
+		Results:    []admin.ClusterDescription20240805{{Name: new("cluster2")}},
+		TotalCount: conversion.IntPtr(2),
+	}, nil, nil).Once()
+	flexAPI := mockadmin.NewFlexClustersAPI(t)
+	flexAPI.EXPECT().ListFlexClustersWithParams(mock.Anything, &admin.ListFlexClustersApiParams{GroupId: dummyProjectID}).Return(admin.ListFlexClustersApiRequest{ApiService: flexAPI}).Once()
+	flexAPI.EXPECT().ListFlexClustersExecute(mock.Anything).Return(&admin.PaginatedFlexClusters20241113{
+		Results:    []admin.FlexClusterDescription20241113{{Name: new("flex1")}},
+		TotalCount: conversion.IntPtr(1),
+	}, nil, nil).Once()
+	client := &admin.APIClient{ClustersAPI: clustersAPI, FlexClustersAPI: flexAPI}
+
+	results := unit.ListResources(t, advancedcluster.ListResource(), advancedcluster.Resource(), client, unit.ListRequest{
+		Attributes: map[string]any{"project_id": dummyProjectID},
+	})
+	names := []string{"cluster1", "cluster2", "flex1"}
+	require.Len(t, results, len(names), "clusters in all pages and flex clusters are listed")
+	for i, name := range names {
+		unit.AssertDiagsOK(t, results[i].Diagnostics)
+		assert.Equal(t, name, results[i].DisplayName)
+		assert.Equal(t, map[string]string{"project_id": dummyProjectID, "name": name}, unit.ListResultIdentity(t, &results[i]))
+	}
+}
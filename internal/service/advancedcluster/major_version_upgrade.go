@@ -0,0 +1,138 @@
+package advancedcluster
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+)
+
+const (
+	operationMajorVersionUpgrade = "major version upgrade"
+	// stagedUpgradeFCVPinDuration is the expiration of the FCV pinned during an upgrade step, it's unpinned when the step finishes.
+	stagedUpgradeFCVPinDuration = 7 * 24 * time.Hour
+)
+
+// majorVersionUpgradePath returns the major versions that a cluster goes through to upgrade from one major version to another,
+// e.g. 6.0 -> 8.0 returns [7.0 8.0]. It returns nil if the major version is not upgraded.
+func majorVersionUpgradePath(from, to string) []string {
+	fromMajor, errFrom := strconv.Atoi(majorComponent(from))
+	toMajor, errTo := strconv.Atoi(majorComponent(to))
+	if errFrom != nil || errTo != nil || toMajor <= fromMajor {
+		return nil
+	}
+	var path []string
+	for major := fromMajor + 1; major < toMajor; major++ {
+		path = append(path, fmt.Sprintf("%d.0", major))
+	}
+	return append(path, FormatMongoDBMajorVersion(to))
+}
+
+// plannedMajorVersionUpgradePath returns the upgrade path of mongo_db_major_version, nil if it's not upgraded or the versions are not known.
+func plannedMajorVersionUpgradePath(state, plan *TFModel) []string {
+	if !isKnown(state.MongoDBMajorVersion) || !isKnown(plan.MongoDBMajorVersion) {
+		return nil
+	}
+	return majorVersionUpgradePath(state.MongoDBMajorVersion.ValueString(), plan.MongoDBMajorVersion.ValueString())
+}
+
+// isStagedMajorVersionUpgrade returns true if the upgrade is applied in isolated steps before other changes:
+// when it upgrades more than one major version or FCV is pinned during the upgrade.
+func isStagedMajorVersionUpgrade(path []string, plan *TFModel) bool {
+	return len(path) > 1 || (len(path) == 1 && plan.PinFCVDuringMajorVersionUpgrade.ValueBool())
+}
+
+// checkMajorVersionUpgrade fails the plan if mongo_db_major_version skips major versions without staged_major_version_upgrade,
+// or if the FCV is pinned in the configuration while the upgrade needs to change it. It warns about the steps of a staged upgrade.
+func checkMajorVersionUpgrade(diags *diag.Diagnostics, state, plan *TFModel) {
+	path := plannedMajorVersionUpgradePath(state, plan)
+	if !isStagedMajorVersionUpgrade(path, plan) {
+		return
+	}
+	from := state.MongoDBMajorVersion.ValueString()
+	steps := strings.Join(append([]string{from}, path...), " -> ")
+	if len(path) > 1 && !plan.StagedMajorVersionUpgrade.ValueBool() {
+		diags.AddError("Invalid mongo_db_major_version upgrade",
+			fmt.Sprintf("mongo_db_major_version can only be upgraded one major version at a time, the upgrade from %s needs the steps %s. "+
+				"Set staged_major_version_upgrade = true to apply the steps in this apply, or upgrade one major version per apply.", from, steps))
+		return
+	}
+	if !plan.PinnedFCV.IsNull() {
+		diags.AddError("Invalid mongo_db_major_version upgrade",
+			"pinned_fcv can't be set while mongo_db_major_version is upgraded in steps or with pin_fcv_during_major_version_upgrade, "+
+				"as the FCV must reach each major version before the next step. Remove pinned_fcv or upgrade one major version per apply.")
+		return
+	}
+	diags.AddWarning("Staged mongo_db_major_version upgrade",
+		fmt.Sprintf("mongo_db_major_version will be upgraded in %d steps: %s. Each step waits for the cluster to be IDLE before the next one, "+
+			"and the rest of the changes are applied after the last step.", len(path), steps))
+}
+
+// stateSetter is implemented by tfsdk.State.
+type stateSetter interface {
+	Set(ctx context.Context, val any) diag.Diagnostics
+}
+
+// applyMajorVersionUpgradeSteps upgrades mongo_db_major_version one major version at a time before the rest of the changes are applied.
+// state.MongoDBMajorVersion is updated after each successful step. If a step fails after others succeeded, state is saved in respState
+// with the version of the cluster so the next apply continues from it.
+func (r *rs) applyMajorVersionUpgradeSteps(ctx context.Context, diags *diag.Diagnostics, respState stateSetter, state, plan *TFModel, waitParams *ClusterWaitParams) *admin.ClusterDescription20240805 {
+	path := plannedMajorVersionUpgradePath(state, plan)
+	if !isStagedMajorVersionUpgrade(path, plan) {
+		return nil
+	}
+	var cluster *admin.ClusterDescription20240805
+	for i, version := range path {
+		cluster = r.upgradeMajorVersionStep(ctx, diags, version, plan.PinFCVDuringMajorVersionUpgrade.ValueBool(), waitParams)
+		if diags.HasError() {
+			if i > 0 {
+				diags.Append(respState.Set(ctx, *state)...)
+			}
+			return nil
+		}
+		state.MongoDBMajorVersion = types.StringValue(version)
+	}
+	// The planned value is kept so the last step is not sent again if it's in other format, e.g. 8 instead of 8.0.
+	state.MongoDBMajorVersion = plan.MongoDBMajorVersion
+	return cluster
+}
+
+// upgradeMajorVersionStep upgrades the cluster to the next major version. If pinFCV is true, the FCV is pinned during the upgrade
+// and unpinned afterwards, also if the upgrade fails to roll back the pin.
+func (r *rs) upgradeMajorVersionStep(ctx context.Context, diags *diag.Diagnostics, version string, pinFCV bool, waitParams *ClusterWaitParams) *admin.ClusterDescription20240805 {
+	var (
+		api         = r.Client.AtlasV2.ClustersAPI
+		projectID   = waitParams.ProjectID
+		clusterName = waitParams.ClusterName
+	)
+	if pinFCV {
+		expirationDate := time.Now().Add(stagedUpgradeFCVPinDuration).UTC().Format(time.RFC3339)
+		if err := PinFCV(ctx, api, projectID, clusterName, expirationDate); err != nil {
+			addErrorDiag(diags, operationFCVPinning, defaultAPIErrorDetails(clusterName, err))
+			return nil
+		}
+		if AwaitChanges(ctx, r.Client, waitParams, operationFCVPinning, diags); diags.HasError() {
+			return nil
+		}
+	}
+	req := &admin.ClusterDescription20240805{MongoDBMajorVersion: &version}
+	cluster := updateCluster(ctx, diags, r.Client, req, waitParams, fmt.Sprintf("%s to %s", operationMajorVersionUpgrade, version))
+	if !pinFCV {
+		return cluster
+	}
+	// Errors unpinning are added after the upgrade error, if any, so both are reported.
+	if _, err := api.UnpinFeatureCompatibilityVersion(ctx, projectID, clusterName).Execute(); err != nil {
+		addErrorDiag(diags, operationFCVUnpinning, defaultAPIErrorDetails(clusterName, err))
+		return nil
+	}
+	unpinnedCluster := AwaitChanges(ctx, r.Client, waitParams, operationFCVUnpinning, diags)
+	if diags.HasError() {
+		return nil
+	}
+	return unpinnedCluster
+}
@@ -0,0 +1,192 @@
+package advancedcluster_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/atlas-sdk/v20250312023/mockadmin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/advancedcluster"
+)
+
+func TestMajorVersionUpgradePath(t *testing.T) {
+	testCases := map[string]struct {
+		from, to string
+		expected []string
+	}{
+		"one major version":  {from: "7.0", to: "8.0", expected: []string{"8.0"}},
+		"two major versions": {from: "6.0", to: "8.0", expected: []string{"7.0", "8.0"}},
+		"short format":       {from: "6", to: "8", expected: []string{"7.0", "8.0"}},
+		"from minor version": {from: "4.4", to: "6.0", expected: []string{"5.0", "6.0"}},
+		"same major version": {from: "7.0", to: "7.0", expected: nil},
+		"rapid release":      {from: "7.0", to: "7.3", expected: nil},
+		"downgrade":          {from: "8.0", to: "7.0", expected: nil},
+		"invalid version":    {from: "", to: "8.0", expected: nil},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, advancedcluster.MajorVersionUpgradePathForTest(tc.from, tc.to))
+		})
+	}
+}
+
+func TestCheckMajorVersionUpgrade(t *testing.T) {
+	pinnedFCV := types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{})
+	testCases := map[string]struct {
+		pinnedFCV      types.Object
+		to             string
+		staged         bool
+		pinFCV         bool
+		expectError    bool
+		expectWarnings int
+	}{
+		"one major version":                 {to: "8.0"},
+		"one major version with pinned FCV": {to: "8.0", pinFCV: true, expectWarnings: 1},
+		"two major versions not staged":     {to: "9.0", expectError: true},
+		"two major versions staged":         {to: "9.0", staged: true, expectWarnings: 1},
+		"staged with pinned_fcv":            {to: "9.0", staged: true, pinnedFCV: pinnedFCV, expectError: true},
+		"pin FCV with pinned_fcv":           {to: "8.0", pinFCV: true, pinnedFCV: pinnedFCV, expectError: true},
+		"pinned_fcv without staged upgrade": {to: "8.0", pinnedFCV: pinnedFCV},
+		"no upgrade":                        {to: "7.0", staged: true, pinFCV: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			state := &advancedcluster.TFModel{MongoDBMajorVersion: types.StringValue("7.0")}
+			plan := &advancedcluster.TFModel{
+				MongoDBMajorVersion:             types.StringValue(tc.to),
+				StagedMajorVersionUpgrade:       types.BoolValue(tc.staged),
+				PinFCVDuringMajorVersionUpgrade: types.BoolValue(tc.pinFCV),
+				PinnedFCV:                       tc.pinnedFCV,
+			}
+			diags := &diag.Diagnostics{}
+			advancedcluster.CheckMajorVersionUpgradeForTest(diags, state, plan)
+			assert.Equal(t, tc.expectError, diags.HasError())
+			assert.Equal(t, tc.expectWarnings, diags.WarningsCount())
+		})
+	}
+}
+
+func TestApplyMajorVersionUpgradeSteps(t *testing.T) {
+	shortenRetries(t)
+	const clusterName = "cluster"
+	testCases := map[string]struct {
+		from, to        string
+		expectedVersion string
+		expectedSaved   string
+		expectedCalls   []string
+		updateErrs      []error
+		staged          bool
+		pinFCV          bool
+		expectedError   bool
+	}{
+		"pin, upgrade and unpin in each step": {
+			from:            "6.0",
+			to:              "8.0",
+			staged:          true,
+			pinFCV:          true,
+			updateErrs:      []error{nil, nil},
+			expectedCalls:   []string{"pin", "upgrade to 7.0", "unpin", "pin", "upgrade to 8.0", "unpin"},
+			expectedVersion: "8.0",
+		},
+		"planned value is kept": {
+			from:            "6",
+			to:              "8",
+			staged:          true,
+			updateErrs:      []error{nil, nil},
+			expectedCalls:   []string{"upgrade to 7.0", "upgrade to 8.0"},
+			expectedVersion: "8",
+		},
+		"unpin after failed upgrade": {
+			from:            "7.0",
+			to:              "8.0",
+			pinFCV:          true,
+			updateErrs:      []error{errGeneric},
+			expectedCalls:   []string{"pin", "upgrade to 8.0", "unpin"},
+			expectedVersion: "7.0",
+			expectedError:   true,
+		},
+		"intermediate version saved after failed step": {
+			from:            "6.0",
+			to:              "8.0",
+			staged:          true,
+			pinFCV:          true,
+			updateErrs:      []error{nil, errGeneric},
+			expectedCalls:   []string{"pin", "upgrade to 7.0", "unpin", "pin", "upgrade to 8.0", "unpin"},
+			expectedVersion: "7.0",
+			expectedSaved:   "7.0",
+			expectedError:   true,
+		},
+		"nothing saved if first step fails": {
+			from:            "6.0",
+			to:              "8.0",
+			staged:          true,
+			updateErrs:      []error{errGeneric},
+			expectedCalls:   []string{"upgrade to 7.0"},
+			expectedVersion: "6.0",
+			expectedError:   true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			m := mockadmin.NewClustersAPI(t)
+			if tc.pinFCV {
+				m.EXPECT().PinFeatureCompatibilityVersion(mock.Anything, dummyProjectID, clusterName, mock.Anything).Return(admin.PinFeatureCompatibilityVersionApiRequest{ApiService: m})
+				m.EXPECT().PinFeatureCompatibilityVersionExecute(mock.Anything).Return(nil, nil, nil).Run(func(admin.PinFeatureCompatibilityVersionApiRequest) {
+					calls = append(calls, "pin")
+				})
+				m.EXPECT().UnpinFeatureCompatibilityVersion(mock.Anything, dummyProjectID, clusterName).Return(admin.UnpinFeatureCompatibilityVersionApiRequest{ApiService: m})
+				m.EXPECT().UnpinFeatureCompatibilityVersionExecute(mock.Anything).Return(nil, nil, nil).Run(func(admin.UnpinFeatureCompatibilityVersionApiRequest) {
+					calls = append(calls, "unpin")
+				})
+			}
+			m.EXPECT().UpdateCluster(mock.Anything, dummyProjectID, clusterName, mock.Anything).Return(admin.UpdateClusterApiRequest{ApiService: m}).
+				Run(func(_ context.Context, _, _ string, req *admin.ClusterDescription20240805) {
+					calls = append(calls, "upgrade to "+req.GetMongoDBMajorVersion())
+				})
+			for _, err := range tc.updateErrs {
+				m.EXPECT().UpdateClusterExecute(mock.Anything).Return(nil, nil, err).Once()
+			}
+			m.EXPECT().GetCluster(mock.Anything, dummyProjectID, clusterName).Return(admin.GetClusterApiRequest{ApiService: m}).Maybe()
+			m.EXPECT().GetClusterExecute(mock.Anything).Return(&admin.ClusterDescription20240805{StateName: conversion.StringPtr("IDLE")}, nil, nil).Maybe()
+
+			state := &advancedcluster.TFModel{MongoDBMajorVersion: types.StringValue(tc.from)}
+			plan := &advancedcluster.TFModel{
+				MongoDBMajorVersion:             types.StringValue(tc.to),
+				StagedMajorVersionUpgrade:       types.BoolValue(tc.staged),
+				PinFCVDuringMajorVersionUpgrade: types.BoolValue(tc.pinFCV),
+			}
+			waitParams := &advancedcluster.ClusterWaitParams{ProjectID: dummyProjectID, ClusterName: clusterName, Timeout: time.Minute}
+			respState := &stateRecorder{}
+			diags := &diag.Diagnostics{}
+			advancedcluster.ApplyMajorVersionUpgradeStepsForTest(t.Context(), m, diags, respState, state, plan, waitParams)
+			assert.Equal(t, tc.expectedError, diags.HasError())
+			assert.Equal(t, tc.expectedCalls, calls)
+			assert.Equal(t, tc.expectedVersion, state.MongoDBMajorVersion.ValueString())
+			if tc.expectedSaved == "" {
+				assert.Empty(t, respState.saved)
+				return
+			}
+			require.Len(t, respState.saved, 1)
+			assert.Equal(t, tc.expectedSaved, respState.saved[0].MongoDBMajorVersion.ValueString())
+		})
+	}
+}
+
+type stateRecorder struct {
+	saved []advancedcluster.TFModel
+}
+
+func (s *stateRecorder) Set(_ context.Context, val any) diag.Diagnostics {
+	s.saved = append(s.saved, val.(advancedcluster.TFModel))
+	return nil
+}
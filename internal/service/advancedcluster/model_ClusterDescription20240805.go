@@ -55,6 +55,7 @@ func newTFModel(ctx context.Context, input *admin.ClusterDescription20240805, di
 		VersionReleaseSystem:                          types.StringValue(conversion.SafeValue(input.VersionReleaseSystem)),
 		AdaptiveCapacity:                              types.StringPointerValue(input.AdaptiveCapacity),
 		PinnedFCV:                                     pinnedFCV,
+		AcknowledgedDisruptiveChanges:                 types.SetNull(types.StringType),
 	}
 }
 
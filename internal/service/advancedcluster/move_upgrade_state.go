@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"slices"
 	"strings"
 
 	"go.mongodb.org/atlas-sdk/v20250312023/admin"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,9 +23,19 @@ import (
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/schemafunc"
 )
 
-// MoveState is used with moved block to upgrade from cluster to adv_cluster
+const (
+	serverlessInstanceTypeName = "mongodbatlas_serverless_instance"
+	errorMoveServerless        = "error moving serverless instance to advanced cluster"
+	errorMoveServerlessDetail  = "%s. Keep managing it with mongodbatlas_serverless_instance and add the moved block after the migration."
+)
+
+// moveSourceTypeNames are the resources that can be moved to adv_cluster with a moved block.
+// Flex clusters and serverless instances (migrated by Atlas to flex clusters) are read with the flex API.
+var moveSourceTypeNames = []string{"mongodbatlas_cluster", "mongodbatlas_flex_cluster", serverlessInstanceTypeName}
+
+// MoveState is used with moved block to upgrade from cluster, flex_cluster or serverless_instance to adv_cluster
 func (r *rs) MoveState(context.Context) []resource.StateMover {
-	return []resource.StateMover{{StateMover: stateMover}}
+	return []resource.StateMover{{StateMover: r.stateMover}}
 }
 
 // UpgradeState is used to upgrade from adv_cluster schema v1 (SDKv2) to v2 (TPF)
@@ -33,12 +45,30 @@ func (r *rs) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader
 	}
 }
 
-func stateMover(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
-	if req.SourceTypeName != "mongodbatlas_cluster" || !strings.HasSuffix(req.SourceProviderAddress, "/mongodbatlas") {
+func (r *rs) stateMover(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !slices.Contains(moveSourceTypeNames, req.SourceTypeName) || !strings.HasSuffix(req.SourceProviderAddress, "/mongodbatlas") {
 		return
 	}
-	// Use always new sharding config when moving from cluster to adv_cluster
+	// Use always new sharding config when moving to adv_cluster
 	setStateResponse(ctx, &resp.Diagnostics, req.SourceRawState, &resp.TargetState, false)
+	if req.SourceTypeName == serverlessInstanceTypeName && !resp.Diagnostics.HasError() {
+		r.checkServerlessMigrated(ctx, &resp.TargetState, &resp.Diagnostics)
+	}
+}
+
+// checkServerlessMigrated fails the move during plan if Atlas hasn't migrated the serverless instance to a flex cluster yet,
+// otherwise the cluster can't be read after the move.
+func (r *rs) checkServerlessMigrated(ctx context.Context, state *tfsdk.State, diags *diag.Diagnostics) {
+	var projectID, name types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("name"), &name)...)
+	if diags.HasError() || r.Client == nil {
+		return
+	}
+	_, _, err := r.Client.AtlasV2.ClustersAPI.GetCluster(ctx, projectID.ValueString(), name.ValueString()).Execute()
+	if admin.IsErrorCode(err, errorCodeServerlessInClusterAPI) {
+		diags.AddError(errorMoveServerless, fmt.Sprintf(errorMoveServerlessDetail, fmt.Sprintf(errorServerlessNotMigrated, name.ValueString())))
+	}
 }
 
 func stateUpgraderFromV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
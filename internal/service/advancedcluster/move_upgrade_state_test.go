@@ -6,12 +6,81 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/atlas-sdk/v20250312023/mockadmin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/advancedcluster"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/unit"
 )
 
+func TestMoveStateServerless(t *testing.T) {
+	const clusterName = "serverless"
+	testCases := map[string]struct {
+		sourceTypeName string
+		errorCode      string
+		expectedError  bool
+	}{
+		"serverless instance not migrated by Atlas is rejected": {
+			sourceTypeName: "mongodbatlas_serverless_instance",
+			errorCode:      "CANNOT_USE_SERVERLESS_INSTANCE_IN_CLUSTER_API",
+			expectedError:  true,
+		},
+		"serverless instance migrated to flex cluster is moved": {
+			sourceTypeName: "mongodbatlas_serverless_instance",
+			errorCode:      "CANNOT_USE_FLEX_CLUSTER_IN_CLUSTER_API",
+		},
+		"flex cluster is moved without checking the cluster": {
+			sourceTypeName: "mongodbatlas_flex_cluster",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			m := mockadmin.NewClustersAPI(t)
+			if tc.errorCode != "" {
+				apiErr := admin.GenericOpenAPIError{}
+				apiErr.SetError(tc.errorCode)
+				apiErr.SetModel(admin.ApiError{ErrorCode: tc.errorCode, Error: 400})
+				m.EXPECT().GetCluster(mock.Anything, dummyProjectID, clusterName).Return(admin.GetClusterApiRequest{ApiService: m}).Once()
+				m.EXPECT().GetClusterExecute(mock.Anything).Return(nil, nil, &apiErr).Once()
+			}
+			schemaResp := fwresource.SchemaResponse{}
+			advancedcluster.Resource().Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+			req := fwresource.MoveStateRequest{
+				SourceTypeName:        tc.sourceTypeName,
+				SourceProviderAddress: "registry.terraform.io/mongodb/mongodbatlas",
+				SourceRawState:        &tfprotov6.RawState{JSON: fmt.Appendf(nil, `{"project_id": %q, "name": %q}`, dummyProjectID, clusterName)},
+			}
+			resp := fwresource.MoveStateResponse{
+				TargetState: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			}
+			advancedcluster.MoveStateForTest(ctx, m, req, &resp)
+			if tc.expectedError {
+				if assert.True(t, resp.Diagnostics.HasError()) {
+					assert.Equal(t, "error moving serverless instance to advanced cluster", resp.Diagnostics.Errors()[0].Summary())
+				}
+				return
+			}
+			unit.AssertDiagsOK(t, resp.Diagnostics)
+			var movedName types.String
+			unit.AssertDiagsOK(t, resp.TargetState.GetAttribute(ctx, path.Root("name"), &movedName))
+			assert.Equal(t, clusterName, movedName.ValueString())
+		})
+	}
+}
+
 func TestAccAdvancedCluster_moveBasic(t *testing.T) {
 	var (
 		projectID, clusterName = acc.ProjectIDExecutionWithCluster(t, 3)
@@ -89,6 +158,47 @@ func TestAccAdvancedCluster_moveFromUnsupportedSource(t *testing.T) {
 	})
 }
 
+func TestAccAdvancedCluster_moveFromFlexClusterAndUpgrade(t *testing.T) {
+	var (
+		projectID   = acc.ProjectIDExecution(t)
+		clusterName = acc.RandomClusterName()
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             acc.CheckDestroyCluster,
+		Steps: []resource.TestStep{
+			{
+				Config: configMoveFirstFlex(projectID, clusterName),
+			},
+			{
+				Config: configMoveSecondFlex(projectID, clusterName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPreRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: configMoveBasic(projectID, clusterName, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("mongodbatlas_advanced_cluster.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("mongodbatlas_advanced_cluster.test", "replication_specs.0.region_configs.0.electable_specs.instance_size", "M10"),
+			},
+			{
+				Config:      configMoveFlex(projectID, clusterName),
+				ExpectError: regexp.MustCompile("Unsupported cluster tier change"),
+			},
+		},
+	})
+}
+
 func configMoveFirst(projectID, clusterName string, numShards int) string {
 	clusterTypeStr := "REPLICASET"
 	if numShards > 1 {
@@ -184,3 +294,45 @@ func configMoveSecondUnsupported(projectID, clusterName string) string {
 		}
 	` + configMoveBasic(projectID, clusterName, 1)
 }
+
+func configMoveFirstFlex(projectID, clusterName string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_flex_cluster" "old" {
+			project_id = %[1]q
+			name       = %[2]q
+			provider_settings = {
+				backing_provider_name = "AWS"
+				region_name           = "US_EAST_1"
+			}
+			termination_protection_enabled = false
+		}
+	`, projectID, clusterName)
+}
+
+func configMoveFlex(projectID, clusterName string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_advanced_cluster" "test" {
+			project_id   = %[1]q
+			name         = %[2]q
+			cluster_type = "REPLICASET"
+			replication_specs = [{
+				region_configs = [{
+					provider_name         = "FLEX"
+					backing_provider_name = "AWS"
+					region_name           = "US_EAST_1"
+					priority              = 7
+				}]
+			}]
+			termination_protection_enabled = false
+		}
+	`, projectID, clusterName)
+}
+
+func configMoveSecondFlex(projectID, clusterName string) string {
+	return `
+		moved {
+			from = mongodbatlas_flex_cluster.old
+			to   = mongodbatlas_advanced_cluster.test
+		}
+	` + configMoveFlex(projectID, clusterName)
+}
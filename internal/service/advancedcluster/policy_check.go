@@ -0,0 +1,30 @@
+package advancedcluster
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/policycheck"
+)
+
+// checkResourcePolicies warns about the resource policies of the organization that may restrict the planned replication_specs.
+// It only runs when the cluster is created or replication_specs change, as Atlas doesn't evaluate policies for other changes.
+func checkResourcePolicies(ctx context.Context, connV2 *admin.APIClient, req *resource.ModifyPlanRequest, diags *diag.Diagnostics) {
+	var plan TFModel
+	diags.Append(req.Plan.Get(ctx, &plan)...)
+	if diags.HasError() || !isKnown(plan.ProjectID) {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state TFModel
+		diags.Append(req.State.Get(ctx, &state)...)
+		if diags.HasError() || state.ReplicationSpecs.Equal(plan.ReplicationSpecs) {
+			return
+		}
+	}
+	diags.Append(policycheck.Check(ctx, connV2, plan.ProjectID.ValueString(), policycheck.ActionClusterModify, path.Root("replication_specs"))...)
+}
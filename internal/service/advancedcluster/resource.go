@@ -23,6 +23,7 @@ var _ resource.ResourceWithImportState = &rs{}
 var _ resource.ResourceWithMoveState = &rs{}
 var _ resource.ResourceWithUpgradeState = &rs{}
 var _ resource.ResourceWithModifyPlan = &rs{}
+var _ resource.ResourceWithIdentity = &rs{}
 
 const (
 	resourceName             = "advanced_cluster"
@@ -36,6 +37,8 @@ const (
 	errorRegionPriorities    = "priority values in region_configs must be in descending order"
 
 	ErrorCodeClusterNotFound             = "CLUSTER_NOT_FOUND"
+	errorCodeServerlessInClusterAPI      = "CANNOT_USE_SERVERLESS_INSTANCE_IN_CLUSTER_API"
+	errorServerlessNotMigrated           = "cluster name: %s is a serverless instance that hasn't been migrated to a flex cluster yet, it can be managed with mongodbatlas_advanced_cluster once Atlas migrates it"
 	operationUpdate                      = "update"
 	operationCreate                      = "create"
 	operationPauseAfterCreate            = "pause after create"
@@ -80,6 +83,12 @@ type rs struct {
 // 1. UseStateForUnknown always copies the state for unknown values. However, that leads to `Error: Provider produced inconsistent result after apply` in some cases (see implementation below).
 // 2. Adding the different UseStateForUnknown is very verbose.
 func (r *rs) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.Client != nil && r.Client.CheckResourcePolicies && !req.Plan.Raw.IsNull() {
+		checkResourcePolicies(ctx, r.Client.AtlasV2, &req, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.Plan.Raw.IsFullyKnown() { // Return early unless it is an Update
 		return
 	}
@@ -101,6 +110,12 @@ func (r *rs) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res
 	if diags.HasError() {
 		return
 	}
+	checkTierMigration(ctx, diags, &state, &plan)
+	checkMajorVersionUpgrade(diags, &state, &plan)
+	warnDisruptiveChanges(ctx, diags, &state, &plan)
+	if diags.HasError() {
+		return
+	}
 	diags.Append(resp.Plan.Set(ctx, plan)...)
 }
 
@@ -109,6 +124,10 @@ func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resou
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *rs) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(resourceSchema(ctx), "project_id", "name")
+}
+
 func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan TFModel
 	diags := &resp.Diagnostics
@@ -243,6 +262,13 @@ func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resou
 	if diags.HasError() {
 		return
 	}
+	// Staged major version upgrades are applied in isolated steps before other changes, each one waiting for the cluster to reach IDLE state
+	if stagedResp := r.applyMajorVersionUpgradeSteps(ctx, diags, &resp.State, &state, &plan, waitParams); stagedResp != nil {
+		clusterResp = stagedResp
+	}
+	if diags.HasError() {
+		return
+	}
 
 	{
 		diff := findClusterDiff(ctx, &state, &plan, diags)
@@ -110,6 +110,10 @@ func OverrideAttributesWithPrevStateValue(modelIn, modelOut *TFModel, diags *dia
 	modelOut.Timeouts = modelIn.Timeouts
 	modelOut.DeleteOnCreateTimeout = modelIn.DeleteOnCreateTimeout
 	modelOut.RetainBackupsEnabled = modelIn.RetainBackupsEnabled
+	modelOut.RequireAckForDisruptiveChanges = modelIn.RequireAckForDisruptiveChanges
+	modelOut.StagedMajorVersionUpgrade = modelIn.StagedMajorVersionUpgrade
+	modelOut.PinFCVDuringMajorVersionUpgrade = modelIn.PinFCVDuringMajorVersionUpgrade
+	modelOut.AcknowledgedDisruptiveChanges = modelIn.AcknowledgedDisruptiveChanges
 	modelOut.UseEffectiveFields = modelIn.UseEffectiveFields
 }
 
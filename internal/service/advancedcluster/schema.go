@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -318,6 +319,26 @@ func resourceSchema(ctx context.Context) schema.Schema {
 				Optional:            true,
 				MarkdownDescription: "Flag that indicates whether to retain backup snapshots for the deleted dedicated cluster.",
 			},
+			"staged_major_version_upgrade": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Flag that indicates whether to upgrade `mongo_db_major_version` through the intermediate major versions when it's increased by more than one major version, e.g. `6.0` -> `7.0` -> `8.0`. Each step is applied before the rest of the changes and waits for the cluster to be `IDLE`. See [Staged major version upgrades](#staged-major-version-upgrades).",
+			},
+			"pin_fcv_during_major_version_upgrade": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Flag that indicates whether to pin the Feature Compatibility Version (FCV) before each step of a `mongo_db_major_version` upgrade and unpin it when the step finishes. If a step fails, the FCV is unpinned to roll back the pin. It can't be used with `pinned_fcv`. See [Staged major version upgrades](#staged-major-version-upgrades).",
+			},
+			"require_ack_for_disruptive_changes": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Flag that indicates whether to fail the plan when it has disruptive changes whose impact is not in `acknowledged_disruptive_changes`. Disruptive changes are always reported as warnings during plan. See [Disruptive changes](#disruptive-changes).",
+			},
+			"acknowledged_disruptive_changes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(disruptiveImpacts...)),
+				},
+				MarkdownDescription: "Impacts of disruptive changes that can be applied when `require_ack_for_disruptive_changes` is true. Valid values are `ROLLING_RESTART`, `RESYNC` and `DOWNTIME_RISK`.",
+			},
 			"advanced_configuration": AdvancedConfigurationSchema(),
 			"pinned_fcv": schema.SingleNestedAttribute{
 				Optional:            true,
@@ -384,6 +405,10 @@ func dataSourceOverridenFields() map[string]dsschema.Attribute {
 		"accept_data_risks_and_force_replica_set_reconfig": nil,
 		"delete_on_create_timeout":                         nil,
 		"retain_backups_enabled":                           nil,
+		"staged_major_version_upgrade":                     nil,
+		"pin_fcv_during_major_version_upgrade":             nil,
+		"require_ack_for_disruptive_changes":               nil,
+		"acknowledged_disruptive_changes":                  nil,
 		"use_effective_fields": dsschema.BoolAttribute{
 			Optional:            true,
 			MarkdownDescription: descUseEffectiveFields,
@@ -684,6 +709,7 @@ func AdvancedConfigurationSchema() schema.SingleNestedAttribute {
 type TFModel struct {
 	ReplicationSpecs                              types.List     `tfsdk:"replication_specs"`
 	Labels                                        types.Map      `tfsdk:"labels"`
+	AcknowledgedDisruptiveChanges                 types.Set      `tfsdk:"acknowledged_disruptive_changes"`
 	Tags                                          types.Map      `tfsdk:"tags"`
 	BiConnectorConfig                             types.Object   `tfsdk:"bi_connector_config"`
 	ClusterType                                   types.String   `tfsdk:"cluster_type"`
@@ -708,6 +734,9 @@ type TFModel struct {
 	AdaptiveCapacity                              types.String   `tfsdk:"adaptive_capacity"`
 	Paused                                        types.Bool     `tfsdk:"paused"`
 	RetainBackupsEnabled                          types.Bool     `tfsdk:"retain_backups_enabled"`
+	RequireAckForDisruptiveChanges                types.Bool     `tfsdk:"require_ack_for_disruptive_changes"`
+	StagedMajorVersionUpgrade                     types.Bool     `tfsdk:"staged_major_version_upgrade"`
+	PinFCVDuringMajorVersionUpgrade               types.Bool     `tfsdk:"pin_fcv_during_major_version_upgrade"`
 	BackupEnabled                                 types.Bool     `tfsdk:"backup_enabled"`
 	GlobalClusterSelfManagedSharding              types.Bool     `tfsdk:"global_cluster_self_managed_sharding"`
 	RedactClientLogData                           types.Bool     `tfsdk:"redact_client_log_data"`
@@ -718,7 +747,7 @@ type TFModel struct {
 	TerminationProtectionEnabled                  types.Bool     `tfsdk:"termination_protection_enabled"`
 }
 
-// TFModelDS differs from TFModel: removes resource-only fields like timeouts, accept_data_risks_and_force_replica_set_reconfig, retain_backups_enabled
+// TFModelDS differs from TFModel: removes resource-only fields like timeouts, accept_data_risks_and_force_replica_set_reconfig, retain_backups_enabled, require_ack_for_disruptive_changes
 type TFModelDS struct {
 	ReplicationSpecs                              types.List   `tfsdk:"replication_specs"`
 	Labels                                        types.Map    `tfsdk:"labels"`
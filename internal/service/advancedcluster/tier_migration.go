@@ -0,0 +1,65 @@
+package advancedcluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/flexcluster"
+)
+
+// Cluster tiers, they're inferred from provider_name of the first region config.
+const (
+	tierShared    = "shared"
+	tierFlex      = "flex"
+	tierDedicated = "dedicated"
+)
+
+// tierUpgrades are the tier changes that are applied in place with the upgrade API, without replacing the cluster.
+var tierUpgrades = map[string][]string{
+	tierShared: {tierFlex, tierDedicated},
+	tierFlex:   {tierDedicated},
+}
+
+// clusterTier returns the tier of the cluster, empty if provider_name is unknown.
+func clusterTier(ctx context.Context, diags *diag.Diagnostics, model *TFModel) string {
+	repSpecs := TFModelList[TFReplicationSpecsModel](ctx, diags, model.ReplicationSpecs)
+	if len(repSpecs) == 0 {
+		return ""
+	}
+	regionConfigs := TFModelList[TFRegionConfigsModel](ctx, diags, repSpecs[0].RegionConfigs)
+	if len(regionConfigs) == 0 || !isKnown(regionConfigs[0].ProviderName) {
+		return ""
+	}
+	switch regionConfigs[0].ProviderName.ValueString() {
+	case constant.TENANT:
+		return tierShared
+	case flexcluster.FlexClusterType:
+		return tierFlex
+	default:
+		return tierDedicated
+	}
+}
+
+// plannedTierChange returns the state and plan tiers, both empty if the tier doesn't change or is unknown.
+func plannedTierChange(ctx context.Context, diags *diag.Diagnostics, state, plan *TFModel) (from, to string) {
+	from, to = clusterTier(ctx, diags, state), clusterTier(ctx, diags, plan)
+	if from == "" || to == "" || from == to {
+		return "", ""
+	}
+	return from, to
+}
+
+// checkTierMigration fails the plan if the cluster tier changes to a tier that it can't be upgraded to, e.g. from dedicated to flex.
+func checkTierMigration(ctx context.Context, diags *diag.Diagnostics, state, plan *TFModel) {
+	from, to := plannedTierChange(ctx, diags, state, plan)
+	if from == "" || slices.Contains(tierUpgrades[from], to) {
+		return
+	}
+	diags.AddError("Unsupported cluster tier change",
+		fmt.Sprintf("cluster %s can't be changed from %s to %s. Clusters can only be upgraded in place from shared to flex or dedicated, and from flex to dedicated. "+
+			"To move to a lower tier, create a new cluster and migrate the data.", plan.Name.ValueString(), from, to))
+}
@@ -0,0 +1,38 @@
+package advancedcluster_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/advancedcluster"
+)
+
+func TestCheckTierMigration(t *testing.T) {
+	testCases := map[string]struct {
+		stateProvider string
+		planProvider  string
+		expectError   bool
+	}{
+		"same tier":           {stateProvider: "AWS", planProvider: "GCP"},
+		"shared to flex":      {stateProvider: "TENANT", planProvider: "FLEX"},
+		"shared to dedicated": {stateProvider: "TENANT", planProvider: "AWS"},
+		"flex to dedicated":   {stateProvider: "FLEX", planProvider: "AZURE"},
+		"flex to shared":      {stateProvider: "FLEX", planProvider: "TENANT", expectError: true},
+		"dedicated to flex":   {stateProvider: "AWS", planProvider: "FLEX", expectError: true},
+		"dedicated to shared": {stateProvider: "AWS", planProvider: "TENANT", expectError: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			state := baseCluster()
+			state.shards[0][0].provider = tc.stateProvider
+			plan := baseCluster()
+			plan.shards[0][0].provider = tc.planProvider
+			planModel := newModel(t, &plan)
+			diags := &diag.Diagnostics{}
+			advancedcluster.CheckTierMigrationForTest(t.Context(), diags, newModel(t, &state), planModel)
+			assert.Equal(t, tc.expectError, diags.HasError(), diags)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
@@ -37,6 +39,8 @@ const (
 
 var _ resource.ResourceWithConfigure = &alertConfigurationRS{}
 var _ resource.ResourceWithImportState = &alertConfigurationRS{}
+var _ resource.ResourceWithIdentity = &alertConfigurationRS{}
+var _ config.ResourceWithImportStateFromIdentity = &alertConfigurationRS{}
 
 func Resource() resource.Resource {
 	return &alertConfigurationRS{
@@ -354,6 +358,12 @@ func (r *alertConfigurationRS) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+func (r *alertConfigurationRS) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(schemaResp.Schema, "project_id", "alert_configuration_id")
+}
+
 func (r *alertConfigurationRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	connV2 := r.Client.AtlasV2
 
@@ -545,3 +555,13 @@ func (r *alertConfigurationRS) ImportState(ctx context.Context, req resource.Imp
 	}))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
 }
+
+// ImportStateFromIdentity is needed because Read uses the encoded resource ID.
+func (r *alertConfigurationRS) ImportStateFromIdentity(ctx context.Context, identity map[string]string, state *tfsdk.State) diag.Diagnostics {
+	diags := state.SetAttribute(ctx, path.Root("id"), conversion.EncodeStateID(map[string]string{
+		EncodedIDKeyAlertID:   identity["alert_configuration_id"],
+		EncodedIDKeyProjectID: identity["project_id"],
+	}))
+	diags.Append(state.SetAttribute(ctx, path.Root("project_id"), identity["project_id"])...)
+	return diags
+}
@@ -81,6 +81,26 @@ func TestAccConfigRSAlertConfiguration_basic(t *testing.T) {
 	})
 }
 
+func TestAccConfigRSAlertConfiguration_importWithIdentity(t *testing.T) {
+	var (
+		projectID = acc.ProjectIDExecution(t)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		TerraformVersionChecks:   acc.IdentityVersionChecks(),
+		CheckDestroy:             checkDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic(projectID, true),
+				Check:  checkExists(resourceName),
+			},
+			acc.ImportStepWithIdentity(resourceName),
+		},
+	})
+}
+
 func TestAccConfigRSAlertConfiguration_outsideStreamProcessorMetricThreshold(t *testing.T) {
 	var (
 		projectID = acc.ProjectIDExecution(t)
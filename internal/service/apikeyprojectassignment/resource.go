@@ -19,6 +19,7 @@ const resourceName = "api_key_project_assignment"
 var (
 	_ resource.ResourceWithConfigure   = &rs{}
 	_ resource.ResourceWithImportState = &rs{}
+	_ resource.ResourceWithIdentity    = &rs{}
 )
 
 func Resource() resource.Resource {
@@ -38,6 +39,10 @@ func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resou
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *rs) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(ResourceSchema(), "project_id", "api_key_id")
+}
+
 func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var tfModel TFModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &tfModel)...)
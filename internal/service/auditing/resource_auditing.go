@@ -44,7 +44,7 @@ func Resource() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				DiffSuppressFunc: diffSuppressJSON,
+				DiffSuppressFunc: schemafunc.DiffSuppressJSON,
 			},
 			"enabled": {
 				Type:     schema.TypeBool,
@@ -152,7 +152,3 @@ func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	d.SetId("")
 	return nil
 }
-
-func diffSuppressJSON(k, old, newStr string, d *schema.ResourceData) bool {
-	return schemafunc.EqualJSON(old, newStr, "audit_filter")
-}
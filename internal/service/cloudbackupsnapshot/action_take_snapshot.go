@@ -0,0 +1,125 @@
+package cloudbackupsnapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/cluster"
+)
+
+const (
+	takeSnapshotActionName = "cloud_backup_take_snapshot"
+	takeSnapshotTimeout    = 1 * time.Hour
+)
+
+var _ action.ActionWithConfigure = &takeSnapshotAction{}
+
+// Wait intervals of the action, variables so unit tests can shorten them.
+var (
+	clusterWaitMinTimeout  = 1 * time.Minute
+	clusterWaitDelay       = 3 * time.Minute
+	snapshotWaitMinTimeout = timeout
+	snapshotWaitDelay      = timeout
+)
+
+func TakeSnapshotAction() action.Action {
+	return &takeSnapshotAction{
+		ActionCommon: config.ActionCommon{
+			ActionName: takeSnapshotActionName,
+		},
+	}
+}
+
+type takeSnapshotAction struct {
+	config.ActionCommon
+}
+
+type TFTakeSnapshotActionModel struct {
+	ProjectID       types.String `tfsdk:"project_id"`
+	ClusterName     types.String `tfsdk:"cluster_name"`
+	Description     types.String `tfsdk:"description"`
+	RetentionInDays types.Int64  `tfsdk:"retention_in_days"`
+}
+
+func (a *takeSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Takes an on-demand snapshot of a cluster and waits until the snapshot is completed. Unlike `mongodbatlas_cloud_backup_snapshot`, the snapshot is not tracked in the Terraform state and is removed by Atlas when its retention expires.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+			},
+			"cluster_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Human-readable label that identifies the cluster.",
+			},
+			"description": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Human-readable phrase or sentence that explains the purpose of the snapshot.",
+			},
+			"retention_in_days": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Number of days that Atlas should retain the on-demand snapshot.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *takeSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model TFTakeSnapshotActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connV2 := a.Client.AtlasV2
+	groupID := model.ProjectID.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	stateConf := cluster.CreateStateChangeConfig(ctx, connV2, groupID, clusterName, takeSnapshotTimeout)
+	stateConf.MinTimeout, stateConf.Delay = clusterWaitMinTimeout, clusterWaitDelay
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		resp.Diagnostics.AddError("error waiting for the cluster to be available", err.Error())
+		return
+	}
+
+	params := &admin.DiskBackupOnDemandSnapshotRequest{
+		Description:     model.Description.ValueStringPointer(),
+		RetentionInDays: new(int(model.RetentionInDays.ValueInt64())),
+	}
+	snapshot, _, err := connV2.CloudBackupsAPI.TakeSnapshots(ctx, groupID, clusterName, params).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error taking a snapshot", err.Error())
+		return
+	}
+	config.SendProgress(resp, "Waiting for snapshot %s of cluster %s to complete", snapshot.GetId(), clusterName)
+
+	requestParams := &admin.GetClusterBackupSnapshotApiParams{
+		GroupId:     groupID,
+		ClusterName: clusterName,
+		SnapshotId:  snapshot.GetId(),
+	}
+	stateConf = retry.StateChangeConf{
+		Pending:    []string{"queued", "inProgress"},
+		Target:     []string{"completed"},
+		Refresh:    resourceRefreshFunc(ctx, requestParams, connV2),
+		Timeout:    takeSnapshotTimeout,
+		MinTimeout: snapshotWaitMinTimeout,
+		Delay:      snapshotWaitDelay,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		resp.Diagnostics.AddError("error waiting for the snapshot to complete", fmt.Sprintf("snapshot %s: %s", snapshot.GetId(), err))
+	}
+}
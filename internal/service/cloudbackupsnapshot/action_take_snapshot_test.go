@@ -0,0 +1,75 @@
+package cloudbackupsnapshot_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/atlas-sdk/v20250312023/mockadmin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/cloudbackupsnapshot"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/unit"
+)
+
+func TestTakeSnapshotActionInvoke(t *testing.T) {
+	cloudbackupsnapshot.ShortenTakeSnapshotWaitsForTest(t)
+	const (
+		projectID   = "projectId"
+		clusterName = "cluster"
+		snapshotID  = "snapshotId"
+	)
+	snapshotParams := &admin.GetClusterBackupSnapshotApiParams{GroupId: projectID, ClusterName: clusterName, SnapshotId: snapshotID}
+	testCases := map[string]struct {
+		takeSnapshotErr  error
+		expectedError    string
+		snapshotStatuses []string
+	}{
+		"snapshot completed": {
+			snapshotStatuses: []string{"queued", "inProgress", "completed"},
+		},
+		"take snapshot error": {
+			takeSnapshotErr: errors.New("take snapshot failed"),
+			expectedError:   "error taking a snapshot",
+		},
+		"snapshot failed": {
+			snapshotStatuses: []string{"inProgress", "failed"},
+			expectedError:    "error waiting for the snapshot to complete",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			clustersAPI := mockadmin.NewClustersAPI(t)
+			clustersAPI.EXPECT().GetCluster(mock.Anything, projectID, clusterName).Return(admin.GetClusterApiRequest{ApiService: clustersAPI}).Once()
+			clustersAPI.EXPECT().GetClusterExecute(mock.Anything).Return(&admin.ClusterDescription20240805{StateName: admin.PtrString("IDLE")}, nil, nil).Once()
+
+			backupsAPI := mockadmin.NewCloudBackupsAPI(t)
+			request := &admin.DiskBackupOnDemandSnapshotRequest{Description: admin.PtrString("description"), RetentionInDays: admin.PtrInt(1)}
+			backupsAPI.EXPECT().TakeSnapshots(mock.Anything, projectID, clusterName, request).Return(admin.TakeSnapshotsApiRequest{ApiService: backupsAPI}).Once()
+			backupsAPI.EXPECT().TakeSnapshotsExecute(mock.Anything).Return(&admin.DiskBackupSnapshot{Id: admin.PtrString(snapshotID)}, nil, tc.takeSnapshotErr).Once()
+			if len(tc.snapshotStatuses) > 0 {
+				backupsAPI.EXPECT().GetClusterBackupSnapshotWithParams(mock.Anything, snapshotParams).Return(admin.GetClusterBackupSnapshotApiRequest{ApiService: backupsAPI})
+				for _, status := range tc.snapshotStatuses {
+					backupsAPI.EXPECT().GetClusterBackupSnapshotExecute(mock.Anything).Return(&admin.DiskBackupReplicaSet{Status: admin.PtrString(status)}, nil, nil).Once()
+				}
+			}
+
+			client := &admin.APIClient{ClustersAPI: clustersAPI, CloudBackupsAPI: backupsAPI}
+			attributes := map[string]any{
+				"project_id":        projectID,
+				"cluster_name":      clusterName,
+				"description":       "description",
+				"retention_in_days": 1,
+			}
+			resp, _ := unit.InvokeAction(t, cloudbackupsnapshot.TakeSnapshotAction(), client, attributes)
+			if tc.expectedError == "" {
+				unit.AssertDiagsOK(t, resp.Diagnostics)
+				return
+			}
+			if assert.Len(t, resp.Diagnostics.Errors(), 1) {
+				assert.Equal(t, tc.expectedError, resp.Diagnostics.Errors()[0].Summary())
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package cloudbackupsnapshot
+
+import (
+	"testing"
+	"time"
+)
+
+func ShortenTakeSnapshotWaitsForTest(t *testing.T) {
+	t.Helper()
+	clusterMinTimeout, clusterDelay := clusterWaitMinTimeout, clusterWaitDelay
+	snapshotMinTimeout, snapshotDelay := snapshotWaitMinTimeout, snapshotWaitDelay
+	clusterWaitMinTimeout, clusterWaitDelay = time.Millisecond, time.Millisecond
+	snapshotWaitMinTimeout, snapshotWaitDelay = time.Millisecond, time.Millisecond
+	t.Cleanup(func() {
+		clusterWaitMinTimeout, clusterWaitDelay = clusterMinTimeout, clusterDelay
+		snapshotWaitMinTimeout, snapshotWaitDelay = snapshotMinTimeout, snapshotDelay
+	})
+}
@@ -20,6 +20,7 @@ const resourceName = "cloud_user_org_assignment"
 
 var _ resource.ResourceWithConfigure = &rs{}
 var _ resource.ResourceWithImportState = &rs{}
+var _ resource.ResourceWithIdentity = &rs{}
 var _ resource.ResourceWithMoveState = &rs{}
 
 func Resource() resource.Resource {
@@ -39,6 +40,10 @@ func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resou
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *rs) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(resourceSchema(), "org_id", "user_id")
+}
+
 func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan TFModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -22,6 +22,7 @@ const (
 
 var _ resource.ResourceWithConfigure = &rs{}
 var _ resource.ResourceWithImportState = &rs{}
+var _ resource.ResourceWithIdentity = &rs{}
 
 func Resource() resource.Resource {
 	return &rs{
@@ -40,6 +41,10 @@ func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resou
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *rs) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(resourceSchema(), "org_id", "team_id", "user_id")
+}
+
 func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan TFUserTeamAssignmentModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -0,0 +1,52 @@
+package clustercostestimate
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const dataSourceName = "cluster_cost_estimate"
+
+var _ datasource.DataSource = &ds{}
+var _ datasource.DataSourceWithConfigure = &ds{}
+
+func DataSource() datasource.DataSource {
+	return &ds{
+		DSCommon: config.DSCommon{
+			DataSourceName: dataSourceName,
+		},
+	}
+}
+
+type ds struct {
+	config.DSCommon
+}
+
+func (d *ds) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DataSourceSchema()
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+// Read doesn't call Atlas so the estimate is available during plan when replication_specs are known.
+func (d *ds) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfModel TFClusterCostEstimateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	table, err := LoadPriceTable()
+	if err != nil {
+		resp.Diagnostics.AddError("error loading price table", err.Error())
+		return
+	}
+	newModel, diags := NewTFClusterCostEstimate(tfModel.ReplicationSpecs, table)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+}
@@ -0,0 +1,157 @@
+package clustercostestimate
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func DataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Estimates the approximate cost of the nodes of a dedicated cluster from the `replication_specs` of a `mongodbatlas_advanced_cluster`, using a table of approximate prices bundled with the provider. The estimate is not a quote and can differ from the Atlas invoice.",
+		Attributes: map[string]schema.Attribute{
+			"replication_specs": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "List of settings that configure your cluster regions, with the same structure as `replication_specs` in `mongodbatlas_advanced_cluster`. Each element is a shard of the cluster.",
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"region_configs": schema.ListNestedAttribute{
+							Required:            true,
+							MarkdownDescription: "Hardware specifications for nodes set for a given region.",
+							Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"provider_name": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Cloud service provider on which the nodes are provisioned. Valid values are `AWS`, `AZURE` and `GCP`.",
+									},
+									"region_name": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Physical location of your MongoDB cluster nodes, e.g. `US_EAST_1`.",
+									},
+									"electable_specs": specsSchema("Hardware specifications for electable nodes in the region."),
+									"read_only_specs": specsSchema("Hardware specifications for read-only nodes in the region."),
+									"analytics_specs": specsSchema("Hardware specifications for analytics nodes in the region."),
+								},
+							},
+						},
+					},
+				},
+			},
+			"line_items": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Approximate cost of each group of nodes in the cluster, in the same order as `replication_specs` and `region_configs`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"provider_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Cloud service provider of the nodes.",
+						},
+						"region_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Region of the nodes.",
+						},
+						"node_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the nodes: `ELECTABLE`, `READ_ONLY` or `ANALYTICS`.",
+						},
+						"instance_size": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Hardware specification of the nodes.",
+						},
+						"node_count": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of nodes.",
+						},
+						"hourly_cost": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Approximate hourly cost of the nodes. Null if the instance size is not in the price table.",
+						},
+						"monthly_cost": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Approximate monthly cost of the nodes. Null if the instance size is not in the price table.",
+						},
+					},
+				},
+			},
+			"hourly_cost": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Approximate hourly cost of the cluster nodes. Null if any instance size is not in the price table.",
+			},
+			"monthly_cost": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Approximate monthly cost of the cluster nodes, using `hours_per_month` hours. Null if any instance size is not in the price table.",
+			},
+			"hours_per_month": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of hours in a month used to estimate the monthly cost.",
+			},
+			"currency": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Currency of the estimated costs.",
+			},
+			"price_table_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the bundled table of approximate prices used to estimate the costs. It changes when the bundled prices are updated, it isn't an Atlas price list version.",
+			},
+		},
+	}
+}
+
+func specsSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"instance_size": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Hardware specification for the instance sizes in this region, e.g. `M30`.",
+			},
+			"node_count": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Number of nodes of this type in the region.",
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+		},
+	}
+}
+
+type TFClusterCostEstimateModel struct {
+	Currency          types.String             `tfsdk:"currency"`
+	PriceTableVersion types.String             `tfsdk:"price_table_version"`
+	HourlyCost        types.Float64            `tfsdk:"hourly_cost"`
+	MonthlyCost       types.Float64            `tfsdk:"monthly_cost"`
+	HoursPerMonth     types.Float64            `tfsdk:"hours_per_month"`
+	ReplicationSpecs  []TFReplicationSpecModel `tfsdk:"replication_specs"`
+	LineItems         []TFLineItemModel        `tfsdk:"line_items"`
+}
+
+type TFReplicationSpecModel struct {
+	RegionConfigs []TFRegionConfigModel `tfsdk:"region_configs"`
+}
+
+type TFRegionConfigModel struct {
+	ElectableSpecs *TFSpecsModel `tfsdk:"electable_specs"`
+	ReadOnlySpecs  *TFSpecsModel `tfsdk:"read_only_specs"`
+	AnalyticsSpecs *TFSpecsModel `tfsdk:"analytics_specs"`
+	ProviderName   types.String  `tfsdk:"provider_name"`
+	RegionName     types.String  `tfsdk:"region_name"`
+}
+
+type TFSpecsModel struct {
+	InstanceSize types.String `tfsdk:"instance_size"`
+	NodeCount    types.Int64  `tfsdk:"node_count"`
+}
+
+type TFLineItemModel struct {
+	HourlyCost   types.Float64 `tfsdk:"hourly_cost"`
+	MonthlyCost  types.Float64 `tfsdk:"monthly_cost"`
+	ProviderName types.String  `tfsdk:"provider_name"`
+	RegionName   types.String  `tfsdk:"region_name"`
+	NodeType     types.String  `tfsdk:"node_type"`
+	InstanceSize types.String  `tfsdk:"instance_size"`
+	NodeCount    types.Int64   `tfsdk:"node_count"`
+}
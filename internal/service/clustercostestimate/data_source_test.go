@@ -0,0 +1,74 @@
+package clustercostestimate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+func TestAccClusterCostEstimateDS_basic(t *testing.T) {
+	dataSourceName := "data.mongodbatlas_cluster_cost_estimate.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "line_items.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "line_items.0.node_type", "ELECTABLE"),
+					resource.TestCheckResourceAttr(dataSourceName, "line_items.0.node_count", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "line_items.1.node_type", "ANALYTICS"),
+					resource.TestCheckResourceAttr(dataSourceName, "line_items.2.region_name", "EU_WEST_1"),
+					resource.TestCheckResourceAttr(dataSourceName, "currency", "USD"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "price_table_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "hourly_cost"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "monthly_cost"),
+				),
+			},
+			{
+				Config: configUnsupportedInstanceSize,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "line_items.#", "1"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "line_items.0.hourly_cost"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "hourly_cost"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "monthly_cost"),
+				),
+			},
+		},
+	})
+}
+
+const configBasic = `
+data "mongodbatlas_cluster_cost_estimate" "test" {
+  replication_specs = [{
+    region_configs = [{
+      provider_name   = "AWS"
+      region_name     = "US_EAST_1"
+      electable_specs = { instance_size = "M30", node_count = 3 }
+      analytics_specs = { instance_size = "M10", node_count = 1 }
+    }]
+  }, {
+    region_configs = [{
+      provider_name   = "AWS"
+      region_name     = "EU_WEST_1"
+      electable_specs = { instance_size = "M30", node_count = 3 }
+      read_only_specs = { instance_size = "M30", node_count = 0 }
+    }]
+  }]
+}
+`
+
+const configUnsupportedInstanceSize = `
+data "mongodbatlas_cluster_cost_estimate" "test" {
+  replication_specs = [{
+    region_configs = [{
+      provider_name   = "AWS"
+      region_name     = "US_EAST_1"
+      electable_specs = { instance_size = "M1000", node_count = 3 }
+    }]
+  }]
+}
+`
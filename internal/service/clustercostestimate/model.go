@@ -0,0 +1,100 @@
+package clustercostestimate
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	nodeTypeElectable = "ELECTABLE"
+	nodeTypeReadOnly  = "READ_ONLY"
+	nodeTypeAnalytics = "ANALYTICS"
+)
+
+// NewTFClusterCostEstimate estimates the cost of the nodes in the replication specs.
+// Regions not in the price table are estimated with the reference region price and a warning is returned.
+// Instance sizes not in the price table have null costs and a warning is returned, the total costs are also null as they would be incomplete.
+func NewTFClusterCostEstimate(replicationSpecs []TFReplicationSpecModel, table *PriceTable) (*TFClusterCostEstimateModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	lineItems := []TFLineItemModel{}
+	unknownRegions := map[string]bool{}
+	unknownInstanceSizes := map[string]bool{}
+	var hourlyCost float64
+	complete := true
+	for _, spec := range replicationSpecs {
+		for _, regionConfig := range spec.RegionConfigs {
+			providerName := regionConfig.ProviderName.ValueString()
+			regionName := regionConfig.RegionName.ValueString()
+			for _, nodes := range []struct {
+				specs    *TFSpecsModel
+				nodeType string
+			}{
+				{regionConfig.ElectableSpecs, nodeTypeElectable},
+				{regionConfig.ReadOnlySpecs, nodeTypeReadOnly},
+				{regionConfig.AnalyticsSpecs, nodeTypeAnalytics},
+			} {
+				if nodes.specs == nil || nodes.specs.NodeCount.ValueInt64() == 0 {
+					continue
+				}
+				instanceSize := nodes.specs.InstanceSize.ValueString()
+				lineItem := TFLineItemModel{
+					ProviderName: types.StringValue(providerName),
+					RegionName:   types.StringValue(regionName),
+					NodeType:     types.StringValue(nodes.nodeType),
+					InstanceSize: types.StringValue(instanceSize),
+					NodeCount:    nodes.specs.NodeCount,
+					HourlyCost:   types.Float64Null(),
+					MonthlyCost:  types.Float64Null(),
+				}
+				nodePrice, knownRegion, err := table.NodeHourlyPrice(providerName, instanceSize, regionName)
+				if errors.Is(err, ErrInstanceSizeNotFound) {
+					complete = false
+					if !unknownInstanceSizes[providerName+instanceSize] {
+						unknownInstanceSizes[providerName+instanceSize] = true
+						diags.AddWarning("Instance size not in the price table",
+							fmt.Sprintf("Instance size %s of cloud provider %s is not in the price table version %s, its nodes and the cluster total costs are not estimated.", instanceSize, providerName, table.Version))
+					}
+					lineItems = append(lineItems, lineItem)
+					continue
+				}
+				if err != nil {
+					diags.AddError("Unable to estimate cluster cost", err.Error())
+					return nil, diags
+				}
+				if !knownRegion && !unknownRegions[providerName+regionName] {
+					unknownRegions[providerName+regionName] = true
+					diags.AddWarning("Region not in the price table",
+						fmt.Sprintf("Region %s of cloud provider %s is not in the price table version %s, its nodes are estimated with the price of the cheapest regions.", regionName, providerName, table.Version))
+				}
+				itemHourlyCost := nodePrice * float64(nodes.specs.NodeCount.ValueInt64())
+				hourlyCost += itemHourlyCost
+				lineItem.HourlyCost = types.Float64Value(roundCost(itemHourlyCost, 4))
+				lineItem.MonthlyCost = types.Float64Value(roundCost(itemHourlyCost*table.HoursPerMonth, 2))
+				lineItems = append(lineItems, lineItem)
+			}
+		}
+	}
+	estimate := &TFClusterCostEstimateModel{
+		ReplicationSpecs:  replicationSpecs,
+		LineItems:         lineItems,
+		HourlyCost:        types.Float64Null(),
+		MonthlyCost:       types.Float64Null(),
+		HoursPerMonth:     types.Float64Value(table.HoursPerMonth),
+		Currency:          types.StringValue(table.Currency),
+		PriceTableVersion: types.StringValue(table.Version),
+	}
+	if complete {
+		estimate.HourlyCost = types.Float64Value(roundCost(hourlyCost, 4))
+		estimate.MonthlyCost = types.Float64Value(roundCost(hourlyCost*table.HoursPerMonth, 2))
+	}
+	return estimate, diags
+}
+
+func roundCost(cost float64, decimals int) float64 {
+	factor := math.Pow10(decimals)
+	return math.Round(cost*factor) / factor
+}
@@ -0,0 +1,143 @@
+package clustercostestimate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/clustercostestimate"
+)
+
+var testPriceTable = &clustercostestimate.PriceTable{
+	Version:       "2026-01-01",
+	Currency:      "USD",
+	HoursPerMonth: 730,
+	Providers: map[string]clustercostestimate.ProviderPrices{
+		"AWS": {
+			InstanceSizes:     map[string]float64{"M10": 0.03, "M30": 0.2},
+			RegionMultipliers: map[string]float64{"US_EAST_1": 1, "EU_WEST_1": 1.5},
+		},
+	},
+}
+
+func specs(instanceSize string, nodeCount int64) *clustercostestimate.TFSpecsModel {
+	return &clustercostestimate.TFSpecsModel{
+		InstanceSize: types.StringValue(instanceSize),
+		NodeCount:    types.Int64Value(nodeCount),
+	}
+}
+
+func regionConfig(regionName string, electable, readOnly, analytics *clustercostestimate.TFSpecsModel) clustercostestimate.TFRegionConfigModel {
+	return clustercostestimate.TFRegionConfigModel{
+		ProviderName:   types.StringValue("AWS"),
+		RegionName:     types.StringValue(regionName),
+		ElectableSpecs: electable,
+		ReadOnlySpecs:  readOnly,
+		AnalyticsSpecs: analytics,
+	}
+}
+
+func TestNewTFClusterCostEstimate(t *testing.T) {
+	replicationSpecs := []clustercostestimate.TFReplicationSpecModel{
+		{RegionConfigs: []clustercostestimate.TFRegionConfigModel{
+			regionConfig("US_EAST_1", specs("M30", 3), nil, specs("M10", 1)),
+		}},
+		{RegionConfigs: []clustercostestimate.TFRegionConfigModel{
+			regionConfig("US_EAST_1", specs("M30", 2), specs("M30", 0), nil),
+			regionConfig("EU_WEST_1", specs("M30", 1), nil, nil),
+		}},
+	}
+	estimate, diags := clustercostestimate.NewTFClusterCostEstimate(replicationSpecs, testPriceTable)
+	require.False(t, diags.HasError())
+	assert.Empty(t, diags)
+	assert.Equal(t, []clustercostestimate.TFLineItemModel{
+		lineItem("US_EAST_1", "ELECTABLE", "M30", 3, 0.6, 438),
+		lineItem("US_EAST_1", "ANALYTICS", "M10", 1, 0.03, 21.9),
+		lineItem("US_EAST_1", "ELECTABLE", "M30", 2, 0.4, 292),
+		lineItem("EU_WEST_1", "ELECTABLE", "M30", 1, 0.3, 219),
+	}, estimate.LineItems, "nodes with node_count 0 are not included")
+	assert.Equal(t, types.Float64Value(1.33), estimate.HourlyCost)
+	assert.Equal(t, types.Float64Value(970.9), estimate.MonthlyCost)
+	assert.Equal(t, types.Float64Value(730), estimate.HoursPerMonth)
+	assert.Equal(t, types.StringValue("USD"), estimate.Currency)
+	assert.Equal(t, types.StringValue("2026-01-01"), estimate.PriceTableVersion)
+	assert.Equal(t, replicationSpecs, estimate.ReplicationSpecs)
+}
+
+func TestNewTFClusterCostEstimateUnknownRegion(t *testing.T) {
+	replicationSpecs := []clustercostestimate.TFReplicationSpecModel{
+		{RegionConfigs: []clustercostestimate.TFRegionConfigModel{
+			regionConfig("AP_EAST_1", specs("M10", 3), specs("M10", 1), nil),
+		}},
+	}
+	estimate, diags := clustercostestimate.NewTFClusterCostEstimate(replicationSpecs, testPriceTable)
+	require.False(t, diags.HasError())
+	assert.Equal(t, 1, diags.WarningsCount(), "only one warning per region")
+	assert.Equal(t, types.Float64Value(0.12), estimate.HourlyCost)
+}
+
+func TestNewTFClusterCostEstimateUnknownInstanceSize(t *testing.T) {
+	replicationSpecs := []clustercostestimate.TFReplicationSpecModel{
+		{RegionConfigs: []clustercostestimate.TFRegionConfigModel{
+			regionConfig("US_EAST_1", specs("M1000", 3), specs("M1000", 1), specs("M10", 1)),
+		}},
+	}
+	estimate, diags := clustercostestimate.NewTFClusterCostEstimate(replicationSpecs, testPriceTable)
+	require.False(t, diags.HasError())
+	assert.Equal(t, 1, diags.WarningsCount(), "only one warning per instance size")
+	unknownItem := lineItem("US_EAST_1", "ELECTABLE", "M1000", 3, 0, 0)
+	unknownItem.HourlyCost, unknownItem.MonthlyCost = types.Float64Null(), types.Float64Null()
+	assert.Equal(t, unknownItem, estimate.LineItems[0])
+	assert.Equal(t, types.Float64Value(0.03), estimate.LineItems[2].HourlyCost, "known instance sizes are still estimated")
+	assert.True(t, estimate.HourlyCost.IsNull(), "total costs would be incomplete")
+	assert.True(t, estimate.MonthlyCost.IsNull())
+	assert.Equal(t, types.StringValue("2026-01-01"), estimate.PriceTableVersion)
+}
+
+func TestNewTFClusterCostEstimateUnsupportedProvider(t *testing.T) {
+	replicationSpecs := []clustercostestimate.TFReplicationSpecModel{
+		{RegionConfigs: []clustercostestimate.TFRegionConfigModel{{
+			ProviderName:   types.StringValue("TENANT"),
+			RegionName:     types.StringValue("US_EAST_1"),
+			ElectableSpecs: specs("M0", 3),
+		}}},
+	}
+	estimate, diags := clustercostestimate.NewTFClusterCostEstimate(replicationSpecs, testPriceTable)
+	assert.True(t, diags.HasError())
+	assert.Nil(t, estimate)
+}
+
+func TestLoadPriceTable(t *testing.T) {
+	table, err := clustercostestimate.LoadPriceTable()
+	require.NoError(t, err)
+	assert.NotEmpty(t, table.Version)
+	assert.Equal(t, "USD", table.Currency)
+	for _, providerName := range []string{"AWS", "AZURE", "GCP"} {
+		provider, ok := table.Providers[providerName]
+		require.True(t, ok, providerName)
+		assert.Contains(t, provider.InstanceSizes, "M10")
+		for region, multiplier := range provider.RegionMultipliers {
+			assert.GreaterOrEqual(t, multiplier, 1.0, "%s %s: reference regions must be the cheapest ones", providerName, region)
+		}
+	}
+	price, knownRegion, err := table.NodeHourlyPrice("aws", "m10", "us_east_1")
+	require.NoError(t, err)
+	assert.True(t, knownRegion, "names are case insensitive")
+	assert.Equal(t, table.Providers["AWS"].InstanceSizes["M10"], price)
+	_, _, err = table.NodeHourlyPrice("AWS", "M1000", "US_EAST_1")
+	assert.ErrorIs(t, err, clustercostestimate.ErrInstanceSizeNotFound)
+}
+
+func lineItem(regionName, nodeType, instanceSize string, nodeCount int64, hourlyCost, monthlyCost float64) clustercostestimate.TFLineItemModel {
+	return clustercostestimate.TFLineItemModel{
+		ProviderName: types.StringValue("AWS"),
+		RegionName:   types.StringValue(regionName),
+		NodeType:     types.StringValue(nodeType),
+		InstanceSize: types.StringValue(instanceSize),
+		NodeCount:    types.Int64Value(nodeCount),
+		HourlyCost:   types.Float64Value(hourlyCost),
+		MonthlyCost:  types.Float64Value(monthlyCost),
+	}
+}
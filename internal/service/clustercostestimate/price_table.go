@@ -0,0 +1,59 @@
+package clustercostestimate
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// priceTableJSON contains approximate list prices of dedicated cluster nodes, they aren't an official Atlas price list.
+// Update the version when prices are changed.
+//
+//go:embed price_table.json
+var priceTableJSON []byte
+
+// ErrInstanceSizeNotFound is returned by NodeHourlyPrice when the instance size is not in the price table.
+var ErrInstanceSizeNotFound = errors.New("instance size is not in the price table")
+
+type PriceTable struct {
+	Providers     map[string]ProviderPrices `json:"providers"`
+	Version       string                    `json:"version"`
+	Currency      string                    `json:"currency"`
+	HoursPerMonth float64                   `json:"hours_per_month"`
+}
+
+type ProviderPrices struct {
+	// InstanceSizes has the hourly price of a node in the reference regions, whose multiplier is 1.
+	InstanceSizes     map[string]float64 `json:"instance_sizes"`
+	RegionMultipliers map[string]float64 `json:"region_multipliers"`
+}
+
+// LoadPriceTable returns the price table bundled with the provider.
+func LoadPriceTable() (*PriceTable, error) {
+	var table PriceTable
+	if err := json.Unmarshal(priceTableJSON, &table); err != nil {
+		return nil, fmt.Errorf("invalid price table: %w", err)
+	}
+	return &table, nil
+}
+
+// NodeHourlyPrice returns the hourly price of a node.
+// Regions not in the price table use the reference region price and knownRegion is false.
+// Instance sizes not in the price table return an error wrapping ErrInstanceSizeNotFound.
+func (t *PriceTable) NodeHourlyPrice(providerName, instanceSize, regionName string) (price float64, knownRegion bool, err error) {
+	provider, ok := t.Providers[strings.ToUpper(providerName)]
+	if !ok {
+		return 0, false, fmt.Errorf("cloud provider %s is not supported, supported providers are dedicated clusters in AWS, AZURE and GCP", providerName)
+	}
+	price, ok = provider.InstanceSizes[strings.ToUpper(instanceSize)]
+	if !ok {
+		return 0, false, fmt.Errorf("%w: %s of cloud provider %s", ErrInstanceSizeNotFound, instanceSize, providerName)
+	}
+	multiplier, knownRegion := provider.RegionMultipliers[strings.ToUpper(regionName)]
+	if !knownRegion {
+		multiplier = 1
+	}
+	return price * multiplier, knownRegion, nil
+}
@@ -0,0 +1,96 @@
+package clusterhealth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	dataSourceName = "cluster_health"
+	// Replication lag is measured every minute, the period gives room for measurements that are not collected yet.
+	lagGranularity = "PT1M"
+	lagPeriod      = "PT10M"
+)
+
+var _ datasource.DataSource = &ds{}
+var _ datasource.DataSourceWithConfigure = &ds{}
+
+func DataSource() datasource.DataSource {
+	return &ds{
+		DSCommon: config.DSCommon{
+			DataSourceName: dataSourceName,
+		},
+	}
+}
+
+type ds struct {
+	config.DSCommon
+}
+
+func (d *ds) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DataSourceSchema()
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (d *ds) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfModel TFClusterHealthModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connV2 := d.Client.AtlasV2
+	projectID := tfModel.ProjectID.ValueString()
+	clusterName := tfModel.ClusterName.ValueString()
+	cluster, _, err := connV2.ClustersAPI.GetCluster(ctx, projectID, clusterName).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching cluster", fmt.Sprintf("cluster %s: %s", clusterName, err.Error()))
+		return
+	}
+	status, _, err := connV2.ClustersAPI.GetClusterStatus(ctx, projectID, clusterName).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching cluster status", fmt.Sprintf("cluster %s: %s", clusterName, err.Error()))
+		return
+	}
+	processes, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.ApiHostViewAtlas], *http.Response, error) {
+		return connV2.MonitoringAndLogsAPI.ListAtlasProcesses(ctx, projectID).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching cluster processes", fmt.Sprintf("cluster %s: %s", clusterName, err.Error()))
+		return
+	}
+	processes = ClusterProcesses(cluster, processes)
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFClusterHealth(projectID, cluster, status, processes, replicationLags(ctx, connV2, projectID, processes)))...)
+}
+
+// replicationLags returns the latest replication lag in seconds of the secondary processes by process ID. Processes without measurements
+// are not returned, e.g. nodes that were just added, and errors are ignored so the health is still returned while a node is unreachable.
+func replicationLags(ctx context.Context, connV2 *admin.APIClient, projectID string, processes []admin.ApiHostViewAtlas) map[string]float64 {
+	lags := make(map[string]float64)
+	for i := range processes {
+		if !IsSecondary(processes[i].GetTypeName()) {
+			continue
+		}
+		measurements, _, err := connV2.MonitoringAndLogsAPI.GetHostMeasurementsWithParams(ctx, &admin.GetHostMeasurementsApiParams{
+			GroupId:     projectID,
+			ProcessId:   processes[i].GetId(),
+			Granularity: conversion.StringPtr(lagGranularity),
+			Period:      conversion.StringPtr(lagPeriod),
+			M:           &[]string{replicationLagMetric},
+		}).Execute()
+		if err != nil {
+			continue
+		}
+		if lag, ok := LatestMeasurement(measurements, replicationLagMetric); ok {
+			lags[processes[i].GetId()] = lag
+		}
+	}
+	return lags
+}
@@ -0,0 +1,130 @@
+package clusterhealth
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func DataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Returns the health of a cluster: its state, pending changes, the location of the primary and the state and replication lag of each node. It's intended for `check` blocks and postconditions after a cluster change.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project, also known as `groupId` in the official documentation.",
+			},
+			"cluster_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Human-readable label that identifies the cluster.",
+			},
+			"state_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Current state of the cluster, e.g. `IDLE`, `CREATING`, `UPDATING`, `REPAIRING` or `DELETING`.",
+			},
+			"idle": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Indicates whether the cluster is in the `IDLE` state.",
+			},
+			"paused": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Indicates whether the cluster is paused.",
+			},
+			"pending_changes": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Indicates whether the latest changes to the cluster or its project are not applied yet.",
+			},
+			"mongo_db_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Version of MongoDB that the cluster runs.",
+			},
+			"primary_provider_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cloud service provider of the highest priority region of the cluster, where the primary is elected when the region is available.",
+			},
+			"primary_region_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Highest priority region of the cluster, where the primary is elected when the region is available.",
+			},
+			"primary_hostnames": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Hostnames of the nodes that are currently primary, one per replica set.",
+			},
+			"max_replication_lag_seconds": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Highest replication lag in seconds of the secondary nodes. Null if no secondary reports replication lag.",
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "MongoDB processes of the cluster.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"hostname": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Hostname of the node as it appears in the connection string of the cluster.",
+						},
+						"port": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Port on which the process listens for requests.",
+						},
+						"type_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type and state of the process, e.g. `REPLICA_PRIMARY`, `REPLICA_SECONDARY`, `SHARD_MONGOS` or `NO_DATA` while the process is deployed.",
+						},
+						"replica_set_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the replica set of the process. Empty for `mongos` processes.",
+						},
+						"shard_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the shard of the process. Empty if the cluster is not sharded.",
+						},
+						"is_primary": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Indicates whether the process is the primary of its replica set.",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Version of MongoDB that the process runs.",
+						},
+						"last_ping": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Date and time in RFC 3339 format when Atlas received the last ping from the process.",
+						},
+						"replication_lag_seconds": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Latest replication lag in seconds of a secondary process. Null for other processes or if Atlas has no measurement yet.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type TFClusterHealthModel struct {
+	ProjectID                types.String        `tfsdk:"project_id"`
+	ClusterName              types.String        `tfsdk:"cluster_name"`
+	StateName                types.String        `tfsdk:"state_name"`
+	MongoDBVersion           types.String        `tfsdk:"mongo_db_version"`
+	PrimaryProviderName      types.String        `tfsdk:"primary_provider_name"`
+	PrimaryRegionName        types.String        `tfsdk:"primary_region_name"`
+	PrimaryHostnames         []string            `tfsdk:"primary_hostnames"`
+	MaxReplicationLagSeconds types.Float64       `tfsdk:"max_replication_lag_seconds"`
+	Nodes                    []TFNodeHealthModel `tfsdk:"nodes"`
+	Idle                     types.Bool          `tfsdk:"idle"`
+	Paused                   types.Bool          `tfsdk:"paused"`
+	PendingChanges           types.Bool          `tfsdk:"pending_changes"`
+}
+
+type TFNodeHealthModel struct {
+	ReplicationLagSeconds types.Float64 `tfsdk:"replication_lag_seconds"`
+	Hostname              types.String  `tfsdk:"hostname"`
+	TypeName              types.String  `tfsdk:"type_name"`
+	ReplicaSetName        types.String  `tfsdk:"replica_set_name"`
+	ShardName             types.String  `tfsdk:"shard_name"`
+	Version               types.String  `tfsdk:"version"`
+	LastPing              types.String  `tfsdk:"last_ping"`
+	Port                  types.Int64   `tfsdk:"port"`
+	IsPrimary             types.Bool    `tfsdk:"is_primary"`
+}
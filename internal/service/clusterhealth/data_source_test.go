@@ -0,0 +1,57 @@
+package clusterhealth_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+func TestAccClusterHealthDS_basic(t *testing.T) {
+	var (
+		projectID, clusterName = acc.ClusterNameExecution(t, false)
+		dataSourceName         = "data.mongodbatlas_cluster_health.test"
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic(projectID, clusterName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_name", clusterName),
+					resource.TestCheckResourceAttr(dataSourceName, "state_name", "IDLE"),
+					resource.TestCheckResourceAttr(dataSourceName, "idle", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "paused", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "pending_changes"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "mongo_db_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_provider_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "primary_region_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "primary_hostnames.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "nodes.#", "3"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "nodes.0.hostname"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "nodes.0.type_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "nodes.0.last_ping"),
+				),
+			},
+		},
+	})
+}
+
+func configBasic(projectID, clusterName string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_cluster_health" "test" {
+			project_id   = %[1]q
+			cluster_name = %[2]q
+
+			lifecycle {
+				postcondition {
+					condition     = self.idle && length(self.primary_hostnames) == 1
+					error_message = "cluster must be IDLE with a primary"
+				}
+			}
+		}
+	`, projectID, clusterName)
+}
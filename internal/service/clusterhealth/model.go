@@ -0,0 +1,168 @@
+package clusterhealth
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/retrystrategy"
+)
+
+const (
+	changeStatusPending = "PENDING"
+	// replicationLagMetric is the host measurement with the seconds that a secondary is behind the primary.
+	replicationLagMetric = "OPLOG_SLAVE_LAG_MASTER_TIME"
+)
+
+// NewTFClusterHealth returns the health of a cluster. lags has the latest replication lag in seconds of the secondary processes by process ID.
+func NewTFClusterHealth(projectID string, cluster *admin.ClusterDescription20240805, status *admin.ClusterStatus, processes []admin.ApiHostViewAtlas, lags map[string]float64) *TFClusterHealthModel {
+	providerName, regionName := primaryRegion(cluster)
+	model := &TFClusterHealthModel{
+		ProjectID:                types.StringValue(projectID),
+		ClusterName:              types.StringValue(cluster.GetName()),
+		StateName:                types.StringValue(cluster.GetStateName()),
+		MongoDBVersion:           types.StringValue(cluster.GetMongoDBVersion()),
+		PrimaryProviderName:      types.StringValue(providerName),
+		PrimaryRegionName:        types.StringValue(regionName),
+		PrimaryHostnames:         []string{},
+		MaxReplicationLagSeconds: types.Float64Null(),
+		Nodes:                    make([]TFNodeHealthModel, len(processes)),
+		Idle:                     types.BoolValue(cluster.GetStateName() == retrystrategy.RetryStrategyIdleState),
+		Paused:                   types.BoolValue(cluster.GetPaused()),
+		PendingChanges:           types.BoolValue(status.GetChangeStatus() == changeStatusPending),
+	}
+	for i := range processes {
+		node := NewTFNodeHealth(&processes[i], lags)
+		if node.IsPrimary.ValueBool() {
+			model.PrimaryHostnames = append(model.PrimaryHostnames, node.Hostname.ValueString())
+		}
+		if lag := node.ReplicationLagSeconds; !lag.IsNull() && (model.MaxReplicationLagSeconds.IsNull() || lag.ValueFloat64() > model.MaxReplicationLagSeconds.ValueFloat64()) {
+			model.MaxReplicationLagSeconds = lag
+		}
+		model.Nodes[i] = node
+	}
+	return model
+}
+
+func NewTFNodeHealth(process *admin.ApiHostViewAtlas, lags map[string]float64) TFNodeHealthModel {
+	lastPing := ""
+	if process.HasLastPing() {
+		lastPing = process.GetLastPing().UTC().Format(time.RFC3339)
+	}
+	lag := types.Float64Null()
+	if value, ok := lags[process.GetId()]; ok {
+		lag = types.Float64Value(value)
+	}
+	return TFNodeHealthModel{
+		Hostname:              types.StringValue(processHostname(process)),
+		Port:                  types.Int64Value(int64(process.GetPort())),
+		TypeName:              types.StringValue(process.GetTypeName()),
+		ReplicaSetName:        types.StringValue(process.GetReplicaSetName()),
+		ShardName:             types.StringValue(process.GetShardName()),
+		IsPrimary:             types.BoolValue(IsPrimary(process.GetTypeName())),
+		Version:               types.StringValue(process.GetVersion()),
+		LastPing:              types.StringValue(lastPing),
+		ReplicationLagSeconds: lag,
+	}
+}
+
+// IsPrimary returns true for the type names of primary processes, e.g. REPLICA_PRIMARY or SHARD_CONFIG_PRIMARY.
+func IsPrimary(typeName string) bool {
+	return strings.HasSuffix(typeName, "_PRIMARY")
+}
+
+// IsSecondary returns true for the type names of secondary processes, e.g. REPLICA_SECONDARY or SHARD_SECONDARY.
+func IsSecondary(typeName string) bool {
+	return strings.HasSuffix(typeName, "_SECONDARY")
+}
+
+// ClusterProcesses returns the processes of the project that belong to the cluster. The processes don't reference their cluster,
+// so they are matched with the hostnames of the standard connection string, which only has the nodes of a replica set or the mongos
+// of a sharded cluster. The other nodes are named like them, <cluster>-shard-NN-NN or <cluster>-config-NN-NN in the same domain.
+func ClusterProcesses(cluster *admin.ClusterDescription20240805, processes []admin.ApiHostViewAtlas) []admin.ApiHostViewAtlas {
+	hosts := connectionStringHosts(cluster.ConnectionStrings.GetStandard())
+	var patterns []*regexp.Regexp
+	for _, host := range hosts {
+		label, domain, _ := strings.Cut(host, ".")
+		if i := strings.LastIndex(label, "-shard-"); i > 0 {
+			patterns = append(patterns, regexp.MustCompile(`^`+regexp.QuoteMeta(label[:i])+`-(shard|config)-\d+-\d+\.`+regexp.QuoteMeta(domain)+`$`))
+		}
+	}
+	var ret []admin.ApiHostViewAtlas
+	for i := range processes {
+		hostname := processHostname(&processes[i])
+		if slices.Contains(hosts, hostname) || slices.ContainsFunc(patterns, func(pattern *regexp.Regexp) bool { return pattern.MatchString(hostname) }) {
+			ret = append(ret, processes[i])
+		}
+	}
+	return ret
+}
+
+// LatestMeasurement returns the value of the most recent data point of a measurement, data points without value are ignored.
+func LatestMeasurement(resp *admin.ApiMeasurementsGeneralViewAtlas, name string) (float64, bool) {
+	for _, measurement := range resp.GetMeasurements() {
+		if measurement.GetName() != name {
+			continue
+		}
+		var (
+			latest    time.Time
+			value     float64
+			hasValue  bool
+			dataPoint = measurement.GetDataPoints()
+		)
+		for i := range dataPoint {
+			if dataPoint[i].HasValue() && (!hasValue || dataPoint[i].GetTimestamp().After(latest)) {
+				latest = dataPoint[i].GetTimestamp()
+				value = float64(dataPoint[i].GetValue())
+				hasValue = true
+			}
+		}
+		return value, hasValue
+	}
+	return 0, false
+}
+
+// primaryRegion returns the provider and region with the highest priority of the first replication spec.
+func primaryRegion(cluster *admin.ClusterDescription20240805) (providerName, regionName string) {
+	specs := cluster.GetReplicationSpecs()
+	if len(specs) == 0 {
+		return "", ""
+	}
+	priority := -1
+	for _, config := range specs[0].GetRegionConfigs() {
+		if config.GetPriority() > priority {
+			priority = config.GetPriority()
+			providerName, regionName = config.GetProviderName(), config.GetRegionName()
+		}
+	}
+	return providerName, regionName
+}
+
+// processHostname returns the hostname of a process as it appears in the connection string.
+func processHostname(process *admin.ApiHostViewAtlas) string {
+	if alias := process.GetUserAlias(); alias != "" {
+		return alias
+	}
+	return process.GetHostname()
+}
+
+// connectionStringHosts returns the hostnames without port of a mongodb:// connection string.
+func connectionStringHosts(connectionString string) []string {
+	hostList, found := strings.CutPrefix(connectionString, "mongodb://")
+	if !found {
+		return nil
+	}
+	if i := strings.IndexAny(hostList, "/?"); i >= 0 {
+		hostList = hostList[:i]
+	}
+	var hosts []string
+	for host := range strings.SplitSeq(hostList, ",") {
+		hostname, _, _ := strings.Cut(host, ":")
+		hosts = append(hosts, hostname)
+	}
+	return hosts
+}
@@ -0,0 +1,166 @@
+package clusterhealth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/clusterhealth"
+)
+
+const (
+	projectID = "111111111111111111111111"
+	domain    = ".abcde.mongodb.net"
+)
+
+var lastPing = time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+
+func process(id, alias, typeName, replicaSetName string) admin.ApiHostViewAtlas {
+	return admin.ApiHostViewAtlas{
+		Id:             admin.PtrString(id),
+		Hostname:       admin.PtrString("atlas-" + alias),
+		UserAlias:      admin.PtrString(alias),
+		Port:           admin.PtrInt(27017),
+		TypeName:       admin.PtrString(typeName),
+		ReplicaSetName: admin.PtrString(replicaSetName),
+		Version:        admin.PtrString("8.0.4"),
+		LastPing:       &lastPing,
+	}
+}
+
+func cluster(standard string) *admin.ClusterDescription20240805 {
+	return &admin.ClusterDescription20240805{
+		Name:              admin.PtrString("cluster0"),
+		StateName:         admin.PtrString("IDLE"),
+		MongoDBVersion:    admin.PtrString("8.0.4"),
+		ConnectionStrings: &admin.ClusterConnectionStrings{Standard: admin.PtrString(standard)},
+		ReplicationSpecs: &[]admin.ReplicationSpec20240805{{
+			RegionConfigs: &[]admin.CloudRegionConfig20240805{
+				{ProviderName: admin.PtrString("AWS"), RegionName: admin.PtrString("US_WEST_2"), Priority: admin.PtrInt(6)},
+				{ProviderName: admin.PtrString("AWS"), RegionName: admin.PtrString("US_EAST_1"), Priority: admin.PtrInt(7)},
+			},
+		}},
+	}
+}
+
+func TestClusterProcesses(t *testing.T) {
+	var (
+		primary      = process("p", "cluster0-shard-00-00"+domain, "REPLICA_PRIMARY", "atlas-x-shard-0")
+		secondary    = process("s", "cluster0-shard-00-01"+domain, "REPLICA_SECONDARY", "atlas-x-shard-0")
+		mongos       = process("m", "cluster0-shard-00-00"+domain, "SHARD_MONGOS", "")
+		config       = process("c", "cluster0-config-00-00"+domain, "SHARD_CONFIG_PRIMARY", "atlas-x-config-0")
+		otherCluster = process("o", "cluster1-shard-00-00"+domain, "REPLICA_PRIMARY", "atlas-y-shard-0")
+		prefixName   = process("n", "cluster0-shard-2-shard-00-00"+domain, "REPLICA_PRIMARY", "atlas-z-shard-0")
+		otherDomain  = process("d", "cluster0-shard-00-02.fghij.mongodb.net", "REPLICA_SECONDARY", "atlas-w-shard-0")
+		processes    = []admin.ApiHostViewAtlas{primary, secondary, mongos, config, otherCluster, prefixName, otherDomain}
+	)
+	testCases := map[string]struct {
+		standard string
+		expected []admin.ApiHostViewAtlas
+	}{
+		"replica set": {
+			standard: "mongodb://cluster0-shard-00-00" + domain + ":27017,cluster0-shard-00-01" + domain + ":27017/?ssl=true&authSource=admin&replicaSet=atlas-x-shard-0",
+			expected: []admin.ApiHostViewAtlas{primary, secondary, mongos, config},
+		},
+		"sharded cluster only lists mongos": {
+			standard: "mongodb://cluster0-shard-00-00" + domain + ":27016/?ssl=true&authSource=admin",
+			expected: []admin.ApiHostViewAtlas{primary, secondary, mongos, config},
+		},
+		"unknown naming only matches hosts": {
+			standard: "mongodb://cluster1-shard-00-00" + domain + ":27017",
+			expected: []admin.ApiHostViewAtlas{otherCluster},
+		},
+		"no connection string": {
+			standard: "",
+			expected: nil,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, clusterhealth.ClusterProcesses(cluster(tc.standard), processes))
+		})
+	}
+}
+
+func TestNewTFClusterHealth(t *testing.T) {
+	processes := []admin.ApiHostViewAtlas{
+		process("p", "cluster0-shard-00-00"+domain, "REPLICA_PRIMARY", "atlas-x-shard-0"),
+		process("s1", "cluster0-shard-00-01"+domain, "REPLICA_SECONDARY", "atlas-x-shard-0"),
+		process("s2", "cluster0-shard-00-02"+domain, "REPLICA_SECONDARY", "atlas-x-shard-0"),
+	}
+	processes[2].LastPing = nil
+	status := &admin.ClusterStatus{ChangeStatus: admin.PtrString("PENDING")}
+	lags := map[string]float64{"s1": 2, "s2": 5}
+	expectedNode := func(hostname, typeName string, isPrimary bool, lastPing string, lag types.Float64) clusterhealth.TFNodeHealthModel {
+		return clusterhealth.TFNodeHealthModel{
+			Hostname:              types.StringValue(hostname),
+			Port:                  types.Int64Value(27017),
+			TypeName:              types.StringValue(typeName),
+			ReplicaSetName:        types.StringValue("atlas-x-shard-0"),
+			ShardName:             types.StringValue(""),
+			IsPrimary:             types.BoolValue(isPrimary),
+			Version:               types.StringValue("8.0.4"),
+			LastPing:              types.StringValue(lastPing),
+			ReplicationLagSeconds: lag,
+		}
+	}
+	expected := &clusterhealth.TFClusterHealthModel{
+		ProjectID:                types.StringValue(projectID),
+		ClusterName:              types.StringValue("cluster0"),
+		StateName:                types.StringValue("IDLE"),
+		MongoDBVersion:           types.StringValue("8.0.4"),
+		PrimaryProviderName:      types.StringValue("AWS"),
+		PrimaryRegionName:        types.StringValue("US_EAST_1"),
+		PrimaryHostnames:         []string{"cluster0-shard-00-00" + domain},
+		MaxReplicationLagSeconds: types.Float64Value(5),
+		Nodes: []clusterhealth.TFNodeHealthModel{
+			expectedNode("cluster0-shard-00-00"+domain, "REPLICA_PRIMARY", true, "2025-03-04T05:06:07Z", types.Float64Null()),
+			expectedNode("cluster0-shard-00-01"+domain, "REPLICA_SECONDARY", false, "2025-03-04T05:06:07Z", types.Float64Value(2)),
+			expectedNode("cluster0-shard-00-02"+domain, "REPLICA_SECONDARY", false, "", types.Float64Value(5)),
+		},
+		Idle:           types.BoolValue(true),
+		Paused:         types.BoolValue(false),
+		PendingChanges: types.BoolValue(true),
+	}
+	assert.Equal(t, expected, clusterhealth.NewTFClusterHealth(projectID, cluster(""), status, processes, lags))
+}
+
+func TestNewTFClusterHealthNoNodes(t *testing.T) {
+	c := cluster("")
+	c.StateName = admin.PtrString("UPDATING")
+	c.ReplicationSpecs = nil
+	health := clusterhealth.NewTFClusterHealth(projectID, c, &admin.ClusterStatus{ChangeStatus: admin.PtrString("APPLIED")}, nil, nil)
+	assert.False(t, health.Idle.ValueBool())
+	assert.False(t, health.PendingChanges.ValueBool())
+	assert.Empty(t, health.PrimaryRegionName.ValueString())
+	assert.Empty(t, health.PrimaryHostnames)
+	assert.True(t, health.MaxReplicationLagSeconds.IsNull())
+	assert.Empty(t, health.Nodes)
+}
+
+func TestLatestMeasurement(t *testing.T) {
+	dataPoint := func(minute int, value *float32) admin.MetricDataPointAtlas {
+		return admin.MetricDataPointAtlas{Timestamp: admin.PtrTime(lastPing.Add(time.Duration(minute) * time.Minute)), Value: value}
+	}
+	resp := &admin.ApiMeasurementsGeneralViewAtlas{
+		Measurements: &[]admin.MetricsMeasurementAtlas{
+			{Name: admin.PtrString("OTHER"), DataPoints: &[]admin.MetricDataPointAtlas{dataPoint(9, admin.PtrFloat32(100))}},
+			{Name: admin.PtrString("OPLOG_SLAVE_LAG_MASTER_TIME"), DataPoints: &[]admin.MetricDataPointAtlas{
+				dataPoint(1, admin.PtrFloat32(1)),
+				dataPoint(3, admin.PtrFloat32(3)),
+				dataPoint(2, admin.PtrFloat32(2)),
+				dataPoint(4, nil),
+			}},
+		},
+	}
+	lag, ok := clusterhealth.LatestMeasurement(resp, "OPLOG_SLAVE_LAG_MASTER_TIME")
+	assert.True(t, ok)
+	assert.InDelta(t, 3.0, lag, 0)
+	_, ok = clusterhealth.LatestMeasurement(resp, "MISSING")
+	assert.False(t, ok)
+	_, ok = clusterhealth.LatestMeasurement(&admin.ApiMeasurementsGeneralViewAtlas{}, "OPLOG_SLAVE_LAG_MASTER_TIME")
+	assert.False(t, ok)
+}
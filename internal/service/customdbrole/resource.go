@@ -22,9 +22,12 @@ import (
 )
 
 // The Custom DB Role APIs do not support concurrent requests to create, update and delete custom db roles.
-// Operations lock on a project level to avoid race conditions within a single apply.
+// Operations lock on a project level with the provider-wide operation lock to avoid race conditions within a single apply,
+// including mongodbatlas_custom_db_role_api resources in the same project.
 // Still, for create/delete we verify that the entry was added/removed to/from the access list and retry otherwise in case of an external change.
-var customDBRoleMutex = concurrency.NewMutexKV()
+func lockProject(projectID string) (unlock func()) {
+	return concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeCustomDBRoles))
+}
 
 func Resource() *schema.Resource {
 	return &schema.Resource{
@@ -122,9 +125,9 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		Pending: []string{"pending"},
 		Target:  []string{"created", "failed"},
 		Refresh: func() (any, string, error) {
-			customDBRoleMutex.Lock(projectID)
+			unlock := lockProject(projectID)
 			customDBRoleRes, _, err := connV2.CustomDatabaseRolesAPI.CreateCustomDbRole(ctx, projectID, customDBRoleReq).Execute()
-			customDBRoleMutex.Unlock(projectID)
+			unlock()
 			if err != nil {
 				if strings.Contains(err.Error(), "Unexpected error") ||
 					strings.Contains(err.Error(), "UNEXPECTED_ERROR") ||
@@ -199,9 +202,9 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 			Actions:        expandActions(d),
 			InheritedRoles: expandInheritedRoles(d),
 		}
-		customDBRoleMutex.Lock(projectID)
+		unlock := lockProject(projectID)
 		_, _, err := connV2.CustomDatabaseRolesAPI.UpdateCustomDbRole(ctx, projectID, roleName, updateParams).Execute()
-		customDBRoleMutex.Unlock(projectID)
+		unlock()
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error updating custom db role (%s): %s", roleName, err))
 		}
@@ -228,9 +231,9 @@ func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.
 				return nil, "failed", err
 			}
 
-			customDBRoleMutex.Lock(projectID)
+			unlock := lockProject(projectID)
 			_, err = connV2.CustomDatabaseRolesAPI.DeleteCustomDbRole(ctx, projectID, roleName).Execute()
-			customDBRoleMutex.Unlock(projectID)
+			unlock()
 			if err != nil {
 				return nil, "failed", fmt.Errorf("error deleting custom db role (%s): %s", roleName, err)
 			}
@@ -0,0 +1,59 @@
+package databaseeffectivepermissions
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// ResourceDatabase is the database of the role, or the collection of the role when it's assigned to a collection.
+	ResourceDatabase    = "DATABASE"
+	ResourceAnyDatabase = "ANY_DATABASE"
+	ResourceCluster     = "CLUSTER"
+)
+
+// builtInRolesJSON contains the privileges of the built-in roles that can be assigned to Atlas database users, using the action names of custom roles.
+// Update mongodb_version when the definitions are reviewed against a newer MongoDB version.
+//
+//go:embed built_in_roles.json
+var builtInRolesJSON []byte
+
+type BuiltInRoles struct {
+	Roles          map[string]BuiltInRole `json:"roles"`
+	MongoDBVersion string                 `json:"mongodb_version"`
+}
+
+type BuiltInRole struct {
+	InheritedRoles []InheritedRole    `json:"inherited_roles"`
+	Privileges     []BuiltInPrivilege `json:"privileges"`
+}
+
+type InheritedRole struct {
+	RoleName     string `json:"role_name"`
+	DatabaseName string `json:"database_name"`
+}
+
+type BuiltInPrivilege struct {
+	// Resource is one of ResourceDatabase, ResourceAnyDatabase or ResourceCluster.
+	Resource string   `json:"resource"`
+	Actions  []string `json:"actions"`
+}
+
+// LoadBuiltInRoles returns the built-in role definitions bundled with the provider.
+func LoadBuiltInRoles() (*BuiltInRoles, error) {
+	var roles BuiltInRoles
+	if err := json.Unmarshal(builtInRolesJSON, &roles); err != nil {
+		return nil, fmt.Errorf("invalid built-in roles: %w", err)
+	}
+	for name, role := range roles.Roles {
+		for _, privilege := range role.Privileges {
+			switch privilege.Resource {
+			case ResourceDatabase, ResourceAnyDatabase, ResourceCluster:
+			default:
+				return nil, fmt.Errorf("invalid built-in roles: role %s has unknown resource %s", name, privilege.Resource)
+			}
+		}
+	}
+	return &roles, nil
+}
@@ -0,0 +1,85 @@
+package databaseeffectivepermissions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	dataSourceName          = "database_effective_permissions"
+	defaultAuthDatabaseName = "admin"
+)
+
+var _ datasource.DataSource = &ds{}
+var _ datasource.DataSourceWithConfigure = &ds{}
+
+func DataSource() datasource.DataSource {
+	return &ds{
+		DSCommon: config.DSCommon{
+			DataSourceName: dataSourceName,
+		},
+	}
+}
+
+type ds struct {
+	config.DSCommon
+}
+
+func (d *ds) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DataSourceSchema()
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (d *ds) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfModel TFDatabaseEffectivePermissionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	builtInRoles, err := LoadBuiltInRoles()
+	if err != nil {
+		resp.Diagnostics.AddError("error loading built-in roles", err.Error())
+		return
+	}
+
+	connV2 := d.Client.AtlasV2
+	projectID := tfModel.ProjectID.ValueString()
+	var roles []RoleRef
+	if username := tfModel.Username.ValueString(); username != "" {
+		if tfModel.AuthDatabaseName.ValueString() == "" {
+			tfModel.AuthDatabaseName = types.StringValue(defaultAuthDatabaseName)
+		}
+		dbUser, _, err := connV2.DatabaseUsersAPI.GetDatabaseUser(ctx, projectID, tfModel.AuthDatabaseName.ValueString(), username).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError("error getting database user information", err.Error())
+			return
+		}
+		for _, role := range dbUser.GetRoles() {
+			roles = append(roles, RoleRef{RoleName: role.RoleName, DatabaseName: role.DatabaseName, CollectionName: role.GetCollectionName()})
+		}
+	} else {
+		tfModel.AuthDatabaseName = types.StringNull()
+		roles = []RoleRef{{RoleName: tfModel.RoleName.ValueString(), DatabaseName: customRolesDatabase}}
+	}
+
+	getCustomRole := func(ctx context.Context, roleName string) (*admin.UserCustomDBRole, error) {
+		customRole, httpResp, err := connV2.CustomDatabaseRolesAPI.GetCustomDbRole(ctx, projectID, roleName).Execute()
+		if validate.StatusNotFound(httpResp) {
+			return nil, nil
+		}
+		return customRole, err
+	}
+	resolution, err := Resolve(ctx, roles, builtInRoles, getCustomRole)
+	if err != nil {
+		resp.Diagnostics.AddError("error resolving effective permissions", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFDatabaseEffectivePermissions(&tfModel, resolution, builtInRoles))...)
+}
@@ -0,0 +1,128 @@
+package databaseeffectivepermissions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func DataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Returns the effective privileges of a database user or a custom db role, resolving built-in and custom inherited roles recursively.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Username of the database user. Exactly one of `username` or `role_name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("username"), path.MatchRoot("role_name")),
+				},
+			},
+			"auth_database_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Database against which the database user authenticates. Defaults to `admin` when `username` is set.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("role_name")),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of the custom db role. A built-in role name can also be used to see its privileges on the `admin` database.",
+			},
+			"roles": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Roles granted directly or through inherited roles, in the order they're resolved.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the role.",
+						},
+						"database_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Database on which the role is granted. Custom db roles are always on the `admin` database.",
+						},
+						"collection_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Collection on which the role is granted, empty if the role is granted on the whole database.",
+						},
+						"built_in": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Flag that indicates whether it's a built-in role or a custom db role.",
+						},
+					},
+				},
+			},
+			"actions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Effective privileges grouped by action, with the same action names as `mongodbatlas_custom_db_role`. Resources already included in a broader resource of the same action are left out.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the privilege action.",
+						},
+						"resources": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Resources on which the action is granted.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"cluster": schema.BoolAttribute{
+										Computed:            true,
+										MarkdownDescription: "Flag that indicates whether the action is granted on the cluster resource.",
+									},
+									"database_name": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Database on which the action is granted, empty for all databases. Null for the cluster resource.",
+									},
+									"collection_name": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Collection on which the action is granted, empty for all collections. Null for the cluster resource.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"built_in_roles_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "MongoDB version of the built-in role definitions bundled with the provider.",
+			},
+		},
+	}
+}
+
+type TFDatabaseEffectivePermissionsModel struct {
+	ProjectID           types.String    `tfsdk:"project_id"`
+	Username            types.String    `tfsdk:"username"`
+	AuthDatabaseName    types.String    `tfsdk:"auth_database_name"`
+	RoleName            types.String    `tfsdk:"role_name"`
+	BuiltInRolesVersion types.String    `tfsdk:"built_in_roles_version"`
+	Roles               []TFRoleModel   `tfsdk:"roles"`
+	Actions             []TFActionModel `tfsdk:"actions"`
+}
+
+type TFRoleModel struct {
+	RoleName       types.String `tfsdk:"role_name"`
+	DatabaseName   types.String `tfsdk:"database_name"`
+	CollectionName types.String `tfsdk:"collection_name"`
+	BuiltIn        types.Bool   `tfsdk:"built_in"`
+}
+
+type TFActionModel struct {
+	Action    types.String      `tfsdk:"action"`
+	Resources []TFResourceModel `tfsdk:"resources"`
+}
+
+type TFResourceModel struct {
+	DatabaseName   types.String `tfsdk:"database_name"`
+	CollectionName types.String `tfsdk:"collection_name"`
+	Cluster        types.Bool   `tfsdk:"cluster"`
+}
@@ -0,0 +1,132 @@
+package databaseeffectivepermissions_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const (
+	dataSourceRoleName = "data.mongodbatlas_database_effective_permissions.role"
+	dataSourceUserName = "data.mongodbatlas_database_effective_permissions.user"
+)
+
+func TestAccDatabaseEffectivePermissionsDS_basic(t *testing.T) {
+	var (
+		projectID       = acc.ProjectIDExecution(t)
+		baseRoleName    = acc.RandomName()
+		derivedRoleName = acc.RandomName()
+		username        = acc.RandomName()
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic(projectID, baseRoleName, derivedRoleName, username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceRoleName, "roles.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceRoleName, "roles.0.role_name", derivedRoleName),
+					resource.TestCheckResourceAttr(dataSourceRoleName, "roles.0.database_name", "admin"),
+					resource.TestCheckResourceAttr(dataSourceRoleName, "roles.0.built_in", "false"),
+					resource.TestCheckResourceAttr(dataSourceRoleName, "actions.#", "10"),
+					resource.TestCheckResourceAttr(dataSourceRoleName, "actions.4.action", "FIND"),
+					resource.TestCheckResourceAttr(dataSourceRoleName, "actions.4.resources.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceRoleName, "actions.4.resources.0.database_name", "inventory"),
+					resource.TestCheckResourceAttr(dataSourceRoleName, "actions.4.resources.1.database_name", "sales"),
+					resource.TestCheckResourceAttr(dataSourceRoleName, "actions.5.action", "INSERT"),
+					resource.TestCheckResourceAttr(dataSourceRoleName, "actions.5.resources.0.collection_name", "orders"),
+					resource.TestCheckResourceAttrSet(dataSourceRoleName, "built_in_roles_version"),
+					resource.TestCheckResourceAttr(dataSourceUserName, "auth_database_name", "admin"),
+					resource.TestCheckResourceAttr(dataSourceUserName, "roles.#", "4"),
+					resource.TestCheckResourceAttrSet(dataSourceUserName, "actions.#"),
+				),
+			},
+			{
+				Config:      configRoleName(projectID, acc.RandomName()),
+				ExpectError: regexp.MustCompile("is neither a built-in role nor a custom db role of the project"),
+			},
+		},
+	})
+}
+
+func configBasic(projectID, baseRoleName, derivedRoleName, username string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_custom_db_role" "base" {
+			project_id = %[1]q
+			role_name  = %[2]q
+
+			actions {
+				action = "INSERT"
+				resources {
+					collection_name = "orders"
+					database_name   = "sales"
+				}
+			}
+		}
+
+		resource "mongodbatlas_custom_db_role" "derived" {
+			project_id = %[1]q
+			role_name  = %[3]q
+
+			actions {
+				action = "FIND"
+				resources {
+					collection_name = ""
+					database_name   = "sales"
+				}
+			}
+
+			inherited_roles {
+				role_name     = mongodbatlas_custom_db_role.base.role_name
+				database_name = "admin"
+			}
+
+			inherited_roles {
+				role_name     = "read"
+				database_name = "inventory"
+			}
+		}
+
+		resource "mongodbatlas_database_user" "test" {
+			project_id         = %[1]q
+			username           = %[4]q
+			password           = "test-acc-password"
+			auth_database_name = "admin"
+
+			roles {
+				role_name     = mongodbatlas_custom_db_role.derived.role_name
+				database_name = "admin"
+			}
+
+			roles {
+				role_name       = "readWrite"
+				database_name   = "sales"
+				collection_name = "orders"
+			}
+		}
+
+		data "mongodbatlas_database_effective_permissions" "role" {
+			project_id = %[1]q
+			role_name  = mongodbatlas_custom_db_role.derived.role_name
+		}
+
+		data "mongodbatlas_database_effective_permissions" "user" {
+			project_id = %[1]q
+			username   = mongodbatlas_database_user.test.username
+		}
+	`, projectID, baseRoleName, derivedRoleName, username)
+}
+
+func configRoleName(projectID, roleName string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_database_effective_permissions" "role" {
+			project_id = %[1]q
+			role_name  = %[2]q
+		}
+	`, projectID, roleName)
+}
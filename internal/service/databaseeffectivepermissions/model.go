@@ -0,0 +1,191 @@
+package databaseeffectivepermissions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+)
+
+// customRolesDatabase is the database of all custom roles.
+const customRolesDatabase = "admin"
+
+type RoleRef struct {
+	RoleName       string
+	DatabaseName   string
+	CollectionName string
+}
+
+type ResolvedRole struct {
+	RoleRef
+	BuiltIn bool
+}
+
+type Privilege struct {
+	Action         string
+	DatabaseName   string
+	CollectionName string
+	Cluster        bool
+}
+
+// Resolution has the roles reached from the initial roles, in the order they're resolved, and the privileges they grant.
+type Resolution struct {
+	Privileges map[Privilege]bool
+	Roles      []ResolvedRole
+}
+
+// CustomRoleGetter returns the custom role with that name in the project, or nil if it doesn't exist.
+type CustomRoleGetter func(ctx context.Context, roleName string) (*admin.UserCustomDBRole, error)
+
+// Resolve expands the roles recursively through the inherited roles of built-in and custom roles.
+// A role reached more than once is resolved only the first time, so inheritance cycles are not a problem.
+func Resolve(ctx context.Context, roles []RoleRef, builtInRoles *BuiltInRoles, getCustomRole CustomRoleGetter) (*Resolution, error) {
+	r := &resolver{
+		builtInRoles:  builtInRoles,
+		getCustomRole: getCustomRole,
+		visited:       map[RoleRef]bool{},
+		resolution:    &Resolution{Privileges: map[Privilege]bool{}},
+	}
+	for _, role := range roles {
+		if err := r.resolve(ctx, role); err != nil {
+			return nil, err
+		}
+	}
+	return r.resolution, nil
+}
+
+type resolver struct {
+	builtInRoles  *BuiltInRoles
+	getCustomRole CustomRoleGetter
+	visited       map[RoleRef]bool
+	resolution    *Resolution
+}
+
+func (r *resolver) resolve(ctx context.Context, role RoleRef) error {
+	if r.visited[role] {
+		return nil
+	}
+	r.visited[role] = true
+
+	if builtInRole, ok := r.builtInRoles.Roles[role.RoleName]; ok {
+		r.resolution.Roles = append(r.resolution.Roles, ResolvedRole{RoleRef: role, BuiltIn: true})
+		for _, privilege := range builtInRole.Privileges {
+			resource := Privilege{Cluster: true}
+			switch privilege.Resource {
+			case ResourceDatabase:
+				resource = Privilege{DatabaseName: role.DatabaseName, CollectionName: role.CollectionName}
+			case ResourceAnyDatabase:
+				resource = Privilege{}
+			}
+			for _, action := range privilege.Actions {
+				resource.Action = action
+				r.resolution.Privileges[resource] = true
+			}
+		}
+		for _, inherited := range builtInRole.InheritedRoles {
+			if err := r.resolve(ctx, RoleRef{RoleName: inherited.RoleName, DatabaseName: inherited.DatabaseName}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	customRole, err := r.getCustomRole(ctx, role.RoleName)
+	if err != nil {
+		return fmt.Errorf("error getting custom db role %s: %w", role.RoleName, err)
+	}
+	if customRole == nil {
+		return fmt.Errorf("role %s is neither a built-in role nor a custom db role of the project", role.RoleName)
+	}
+	r.resolution.Roles = append(r.resolution.Roles, ResolvedRole{RoleRef: RoleRef{RoleName: role.RoleName, DatabaseName: customRolesDatabase}})
+	for _, action := range customRole.GetActions() {
+		for _, resource := range action.GetResources() {
+			privilege := Privilege{Action: action.Action, Cluster: true}
+			if !resource.Cluster {
+				privilege = Privilege{Action: action.Action, DatabaseName: resource.Db, CollectionName: resource.Collection}
+			}
+			r.resolution.Privileges[privilege] = true
+		}
+	}
+	for _, inherited := range customRole.GetInheritedRoles() {
+		if err := r.resolve(ctx, RoleRef{RoleName: inherited.Role, DatabaseName: inherited.Db}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewTFDatabaseEffectivePermissions groups the privileges by action. Privileges already granted by a broader resource
+// of the same action are left out: all databases include every database, and a database includes all its collections.
+func NewTFDatabaseEffectivePermissions(input *TFDatabaseEffectivePermissionsModel, resolution *Resolution, builtInRoles *BuiltInRoles) *TFDatabaseEffectivePermissionsModel {
+	roles := make([]TFRoleModel, 0, len(resolution.Roles))
+	for _, role := range resolution.Roles {
+		roles = append(roles, TFRoleModel{
+			RoleName:       types.StringValue(role.RoleName),
+			DatabaseName:   types.StringValue(role.DatabaseName),
+			CollectionName: types.StringValue(role.CollectionName),
+			BuiltIn:        types.BoolValue(role.BuiltIn),
+		})
+	}
+
+	privileges := make([]Privilege, 0, len(resolution.Privileges))
+	for privilege := range resolution.Privileges {
+		if !isGrantedByBroaderResource(privilege, resolution.Privileges) {
+			privileges = append(privileges, privilege)
+		}
+	}
+	sort.Slice(privileges, func(i, j int) bool {
+		a, b := privileges[i], privileges[j]
+		if a.Action != b.Action {
+			return a.Action < b.Action
+		}
+		if a.Cluster != b.Cluster {
+			return a.Cluster
+		}
+		if a.DatabaseName != b.DatabaseName {
+			return a.DatabaseName < b.DatabaseName
+		}
+		return a.CollectionName < b.CollectionName
+	})
+	actions := []TFActionModel{}
+	for _, privilege := range privileges {
+		if len(actions) == 0 || actions[len(actions)-1].Action.ValueString() != privilege.Action {
+			actions = append(actions, TFActionModel{Action: types.StringValue(privilege.Action)})
+		}
+		resource := TFResourceModel{
+			Cluster:        types.BoolValue(true),
+			DatabaseName:   types.StringNull(),
+			CollectionName: types.StringNull(),
+		}
+		if !privilege.Cluster {
+			resource = TFResourceModel{
+				Cluster:        types.BoolValue(false),
+				DatabaseName:   types.StringValue(privilege.DatabaseName),
+				CollectionName: types.StringValue(privilege.CollectionName),
+			}
+		}
+		actions[len(actions)-1].Resources = append(actions[len(actions)-1].Resources, resource)
+	}
+
+	return &TFDatabaseEffectivePermissionsModel{
+		ProjectID:           input.ProjectID,
+		Username:            input.Username,
+		AuthDatabaseName:    input.AuthDatabaseName,
+		RoleName:            input.RoleName,
+		Roles:               roles,
+		Actions:             actions,
+		BuiltInRolesVersion: types.StringValue(builtInRoles.MongoDBVersion),
+	}
+}
+
+func isGrantedByBroaderResource(privilege Privilege, privileges map[Privilege]bool) bool {
+	if privilege.Cluster || privilege.DatabaseName == "" {
+		return false
+	}
+	if privileges[Privilege{Action: privilege.Action}] {
+		return true
+	}
+	return privilege.CollectionName != "" && privileges[Privilege{Action: privilege.Action, DatabaseName: privilege.DatabaseName}]
+}
@@ -0,0 +1,215 @@
+package databaseeffectivepermissions_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/databaseeffectivepermissions"
+)
+
+var testBuiltInRoles = &databaseeffectivepermissions.BuiltInRoles{
+	MongoDBVersion: "8.0",
+	Roles: map[string]databaseeffectivepermissions.BuiltInRole{
+		"read": {Privileges: []databaseeffectivepermissions.BuiltInPrivilege{
+			{Resource: databaseeffectivepermissions.ResourceDatabase, Actions: []string{"FIND", "LIST_COLLECTIONS"}},
+		}},
+		"readAnyDatabase": {Privileges: []databaseeffectivepermissions.BuiltInPrivilege{
+			{Resource: databaseeffectivepermissions.ResourceAnyDatabase, Actions: []string{"FIND"}},
+			{Resource: databaseeffectivepermissions.ResourceCluster, Actions: []string{"LIST_DATABASES"}},
+		}},
+		"atlasAdmin": {
+			InheritedRoles: []databaseeffectivepermissions.InheritedRole{{RoleName: "readAnyDatabase", DatabaseName: "admin"}},
+			Privileges: []databaseeffectivepermissions.BuiltInPrivilege{
+				{Resource: databaseeffectivepermissions.ResourceCluster, Actions: []string{"KILL_OP"}},
+			},
+		},
+	},
+}
+
+func customRole(name string, actions []admin.DatabasePrivilegeAction, inheritedRoles ...admin.DatabaseInheritedRole) *admin.UserCustomDBRole {
+	return &admin.UserCustomDBRole{
+		RoleName:       name,
+		Actions:        &actions,
+		InheritedRoles: &inheritedRoles,
+	}
+}
+
+func action(name, db, collection string) admin.DatabasePrivilegeAction {
+	return admin.DatabasePrivilegeAction{
+		Action:    name,
+		Resources: &[]admin.DatabasePermittedNamespaceResource{{Db: db, Collection: collection}},
+	}
+}
+
+var testCustomRoles = map[string]*admin.UserCustomDBRole{
+	"ordersWriter": customRole("ordersWriter", []admin.DatabasePrivilegeAction{action("INSERT", "sales", "orders")}),
+	"salesAnalyst": customRole("salesAnalyst", []admin.DatabasePrivilegeAction{action("FIND", "sales", "orders")},
+		admin.DatabaseInheritedRole{Role: "ordersWriter", Db: "admin"},
+		admin.DatabaseInheritedRole{Role: "read", Db: "inventory"},
+	),
+	"cycleA": customRole("cycleA", []admin.DatabasePrivilegeAction{action("FIND", "a", "")}, admin.DatabaseInheritedRole{Role: "cycleB", Db: "admin"}),
+	"cycleB": customRole("cycleB", []admin.DatabasePrivilegeAction{action("FIND", "b", "")}, admin.DatabaseInheritedRole{Role: "cycleA", Db: "admin"}),
+	"broken": customRole("broken", nil, admin.DatabaseInheritedRole{Role: "missing", Db: "admin"}),
+}
+
+func getTestCustomRole(_ context.Context, roleName string) (*admin.UserCustomDBRole, error) {
+	if roleName == "unavailable" {
+		return nil, errors.New("service unavailable")
+	}
+	return testCustomRoles[roleName], nil
+}
+
+func TestResolve(t *testing.T) {
+	testCases := map[string]struct {
+		expectedError      string
+		roles              []databaseeffectivepermissions.RoleRef
+		expectedRoles      []databaseeffectivepermissions.ResolvedRole
+		expectedPrivileges []databaseeffectivepermissions.Privilege
+	}{
+		"built-in role on collection": {
+			roles: []databaseeffectivepermissions.RoleRef{{RoleName: "read", DatabaseName: "sales", CollectionName: "orders"}},
+			expectedRoles: []databaseeffectivepermissions.ResolvedRole{
+				{RoleRef: databaseeffectivepermissions.RoleRef{RoleName: "read", DatabaseName: "sales", CollectionName: "orders"}, BuiltIn: true},
+			},
+			expectedPrivileges: []databaseeffectivepermissions.Privilege{
+				{Action: "FIND", DatabaseName: "sales", CollectionName: "orders"},
+				{Action: "LIST_COLLECTIONS", DatabaseName: "sales", CollectionName: "orders"},
+			},
+		},
+		"built-in inherited roles": {
+			roles: []databaseeffectivepermissions.RoleRef{{RoleName: "atlasAdmin", DatabaseName: "admin"}},
+			expectedRoles: []databaseeffectivepermissions.ResolvedRole{
+				{RoleRef: databaseeffectivepermissions.RoleRef{RoleName: "atlasAdmin", DatabaseName: "admin"}, BuiltIn: true},
+				{RoleRef: databaseeffectivepermissions.RoleRef{RoleName: "readAnyDatabase", DatabaseName: "admin"}, BuiltIn: true},
+			},
+			expectedPrivileges: []databaseeffectivepermissions.Privilege{
+				{Action: "KILL_OP", Cluster: true},
+				{Action: "FIND"},
+				{Action: "LIST_DATABASES", Cluster: true},
+			},
+		},
+		"custom inherited roles": {
+			roles: []databaseeffectivepermissions.RoleRef{{RoleName: "salesAnalyst", DatabaseName: "admin"}},
+			expectedRoles: []databaseeffectivepermissions.ResolvedRole{
+				{RoleRef: databaseeffectivepermissions.RoleRef{RoleName: "salesAnalyst", DatabaseName: "admin"}},
+				{RoleRef: databaseeffectivepermissions.RoleRef{RoleName: "ordersWriter", DatabaseName: "admin"}},
+				{RoleRef: databaseeffectivepermissions.RoleRef{RoleName: "read", DatabaseName: "inventory"}, BuiltIn: true},
+			},
+			expectedPrivileges: []databaseeffectivepermissions.Privilege{
+				{Action: "FIND", DatabaseName: "sales", CollectionName: "orders"},
+				{Action: "INSERT", DatabaseName: "sales", CollectionName: "orders"},
+				{Action: "FIND", DatabaseName: "inventory"},
+				{Action: "LIST_COLLECTIONS", DatabaseName: "inventory"},
+			},
+		},
+		"inheritance cycle": {
+			roles: []databaseeffectivepermissions.RoleRef{{RoleName: "cycleA", DatabaseName: "admin"}},
+			expectedRoles: []databaseeffectivepermissions.ResolvedRole{
+				{RoleRef: databaseeffectivepermissions.RoleRef{RoleName: "cycleA", DatabaseName: "admin"}},
+				{RoleRef: databaseeffectivepermissions.RoleRef{RoleName: "cycleB", DatabaseName: "admin"}},
+			},
+			expectedPrivileges: []databaseeffectivepermissions.Privilege{
+				{Action: "FIND", DatabaseName: "a"},
+				{Action: "FIND", DatabaseName: "b"},
+			},
+		},
+		"unknown inherited role": {
+			roles:         []databaseeffectivepermissions.RoleRef{{RoleName: "broken", DatabaseName: "admin"}},
+			expectedError: "role missing is neither a built-in role nor a custom db role of the project",
+		},
+		"custom role error": {
+			roles:         []databaseeffectivepermissions.RoleRef{{RoleName: "unavailable", DatabaseName: "admin"}},
+			expectedError: "error getting custom db role unavailable: service unavailable",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resolution, err := databaseeffectivepermissions.Resolve(t.Context(), tc.roles, testBuiltInRoles, getTestCustomRole)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRoles, resolution.Roles)
+			expectedPrivileges := map[databaseeffectivepermissions.Privilege]bool{}
+			for _, privilege := range tc.expectedPrivileges {
+				expectedPrivileges[privilege] = true
+			}
+			assert.Equal(t, expectedPrivileges, resolution.Privileges)
+		})
+	}
+}
+
+func TestNewTFDatabaseEffectivePermissions(t *testing.T) {
+	input := &databaseeffectivepermissions.TFDatabaseEffectivePermissionsModel{
+		ProjectID:        types.StringValue("project"),
+		Username:         types.StringValue("user"),
+		AuthDatabaseName: types.StringValue("admin"),
+		RoleName:         types.StringNull(),
+	}
+	resolution := &databaseeffectivepermissions.Resolution{
+		Roles: []databaseeffectivepermissions.ResolvedRole{
+			{RoleRef: databaseeffectivepermissions.RoleRef{RoleName: "read", DatabaseName: "sales", CollectionName: "orders"}, BuiltIn: true},
+		},
+		Privileges: map[databaseeffectivepermissions.Privilege]bool{
+			{Action: "FIND", DatabaseName: "sales", CollectionName: "orders"}:   true,
+			{Action: "FIND", DatabaseName: "sales"}:                             true,
+			{Action: "INSERT", DatabaseName: "sales", CollectionName: "orders"}: true,
+			{Action: "INSERT", DatabaseName: "inventory"}:                       true,
+			{Action: "INSERT"}:                 true,
+			{Action: "INSERT", Cluster: true}:  true,
+			{Action: "KILL_OP", Cluster: true}: true,
+		},
+	}
+	clusterResource := databaseeffectivepermissions.TFResourceModel{
+		Cluster:        types.BoolValue(true),
+		DatabaseName:   types.StringNull(),
+		CollectionName: types.StringNull(),
+	}
+	namespaceResource := func(db, collection string) databaseeffectivepermissions.TFResourceModel {
+		return databaseeffectivepermissions.TFResourceModel{
+			Cluster:        types.BoolValue(false),
+			DatabaseName:   types.StringValue(db),
+			CollectionName: types.StringValue(collection),
+		}
+	}
+	expected := &databaseeffectivepermissions.TFDatabaseEffectivePermissionsModel{
+		ProjectID:           types.StringValue("project"),
+		Username:            types.StringValue("user"),
+		AuthDatabaseName:    types.StringValue("admin"),
+		RoleName:            types.StringNull(),
+		BuiltInRolesVersion: types.StringValue("8.0"),
+		Roles: []databaseeffectivepermissions.TFRoleModel{{
+			RoleName:       types.StringValue("read"),
+			DatabaseName:   types.StringValue("sales"),
+			CollectionName: types.StringValue("orders"),
+			BuiltIn:        types.BoolValue(true),
+		}},
+		Actions: []databaseeffectivepermissions.TFActionModel{
+			{Action: types.StringValue("FIND"), Resources: []databaseeffectivepermissions.TFResourceModel{namespaceResource("sales", "")}},
+			{Action: types.StringValue("INSERT"), Resources: []databaseeffectivepermissions.TFResourceModel{clusterResource, namespaceResource("", "")}},
+			{Action: types.StringValue("KILL_OP"), Resources: []databaseeffectivepermissions.TFResourceModel{clusterResource}},
+		},
+	}
+	assert.Equal(t, expected, databaseeffectivepermissions.NewTFDatabaseEffectivePermissions(input, resolution, testBuiltInRoles))
+}
+
+func TestLoadBuiltInRoles(t *testing.T) {
+	builtInRoles, err := databaseeffectivepermissions.LoadBuiltInRoles()
+	require.NoError(t, err)
+	assert.NotEmpty(t, builtInRoles.MongoDBVersion)
+	for _, name := range []string{"atlasAdmin", "readWriteAnyDatabase", "readAnyDatabase", "clusterMonitor", "backup", "dbAdmin", "dbAdminAnyDatabase", "enableSharding", "read", "readWrite"} {
+		assert.Contains(t, builtInRoles.Roles, name)
+	}
+	for name, role := range builtInRoles.Roles {
+		for _, inherited := range role.InheritedRoles {
+			assert.Contains(t, builtInRoles.Roles, inherited.RoleName, "inherited role of %s", name)
+		}
+	}
+}
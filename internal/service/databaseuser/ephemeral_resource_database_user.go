@@ -0,0 +1,305 @@
+package databaseuser
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/databaseusercredentials"
+)
+
+const (
+	temporaryUserAuthDatabaseName = "admin"
+	temporaryUserPasswordLength   = 32
+	defaultDeleteAfterHours       = 1
+	// maxDeleteAfterHours is one week, the maximum delete_after_date accepted by Atlas.
+	maxDeleteAfterHours = 7 * 24
+	closeDataKey        = "delete_data"
+)
+
+var _ ephemeral.EphemeralResource = &databaseUserES{}
+var _ ephemeral.EphemeralResourceWithConfigure = &databaseUserES{}
+var _ ephemeral.EphemeralResourceWithClose = &databaseUserES{}
+
+type databaseUserES struct {
+	config.ESCommon
+}
+
+// EphemeralResource returns an ephemeral resource that creates a temporary database user with a generated password.
+// The user is deleted when the ephemeral resource is closed, and Atlas deletes it after delete_after_hours if the deletion fails.
+func EphemeralResource() ephemeral.EphemeralResource {
+	return &databaseUserES{
+		ESCommon: config.ESCommon{
+			ResourceName: databaseUserResourceName,
+		},
+	}
+}
+
+type TfDatabaseUserEphemeralModel struct {
+	ProjectID        types.String `tfsdk:"project_id"`
+	Username         types.String `tfsdk:"username"`
+	Description      types.String `tfsdk:"description"`
+	ClusterName      types.String `tfsdk:"cluster_name"`
+	Roles            types.Set    `tfsdk:"roles"`
+	Scopes           types.Set    `tfsdk:"scopes"`
+	AuthDatabaseName types.String `tfsdk:"auth_database_name"`
+	Password         types.String `tfsdk:"password"`
+	DeleteAfterDate  types.String `tfsdk:"delete_after_date"`
+	ConnectionString types.String `tfsdk:"connection_string"`
+	DeleteAfterHours types.Int64  `tfsdk:"delete_after_hours"`
+}
+
+type closeData struct {
+	ProjectID string `json:"project_id"`
+	Username  string `json:"username"`
+}
+
+func (r *databaseUserES) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Username of the temporary database user. Defaults to a random username with the `tmp-` prefix.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the temporary database user.",
+			},
+			"cluster_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of the cluster to return the `connection_string` for. If `scopes` isn't set, the user only has access to this cluster.",
+			},
+			"delete_after_hours": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxDeleteAfterHours),
+				},
+				MarkdownDescription: fmt.Sprintf("Number of hours after which Atlas deletes the user if it isn't deleted when the Terraform run ends, between 1 and %d. Defaults to `%d`.", maxDeleteAfterHours, defaultDeleteAfterHours),
+			},
+			"roles": schema.SetNestedAttribute{
+				Required: true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Name of the role to grant.",
+						},
+						"database_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Database on which the role is granted.",
+						},
+						"collection_name": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Collection on which the role is granted.",
+						},
+					},
+				},
+				MarkdownDescription: "Roles of the temporary database user.",
+			},
+			"scopes": schema.SetNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Name of the cluster or Atlas Data Federation that the user has access to.",
+						},
+						"type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Type of resource that the user has access to, `CLUSTER` or `DATA_LAKE`.",
+						},
+					},
+				},
+				MarkdownDescription: "Clusters and Atlas Data Federation that the user has access to. If neither `scopes` nor `cluster_name` are set, the user has access to all of them in the project.",
+			},
+			"auth_database_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Database against which Atlas authenticates the user, always `admin`.",
+			},
+			"password": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Generated password of the temporary database user.",
+			},
+			"delete_after_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Date and time when Atlas deletes the user, in RFC3339 format.",
+			},
+			"connection_string": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Standard SRV connection string of `cluster_name` with the credentials of the temporary database user. Null if `cluster_name` isn't set.",
+			},
+		},
+	}
+}
+
+func (r *databaseUserES) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model TfDatabaseUserEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.EphemeralResourceData == nil || r.EphemeralResourceData.Client == nil {
+		resp.Diagnostics.AddError("Provider not configured", "the provider must be configured to create a temporary database user")
+		return
+	}
+
+	if model.Username.IsNull() {
+		model.Username = types.StringValue("tmp-" + strings.ToLower(rand.Text()[:12]))
+	}
+	password, err := databaseusercredentials.GeneratePassword(temporaryUserPasswordLength, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating database user password", err.Error())
+		return
+	}
+	deleteAfterHours := int64(defaultDeleteAfterHours)
+	if !model.DeleteAfterHours.IsNull() {
+		deleteAfterHours = model.DeleteAfterHours.ValueInt64()
+	}
+	deleteAfterDate := time.Now().UTC().Add(time.Duration(deleteAfterHours) * time.Hour).Truncate(time.Second)
+
+	dbUserReq, diags := NewMongoDBTemporaryDatabaseUser(ctx, &model, password, deleteAfterDate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := model.ProjectID.ValueString()
+	username := model.Username.ValueString()
+	connV2 := r.EphemeralResourceData.Client.AtlasV2
+	if _, _, err := connV2.DatabaseUsersAPI.CreateDatabaseUser(ctx, projectID, dbUserReq).Execute(); err != nil {
+		resp.Diagnostics.AddError("error during temporary database user creation", err.Error())
+		return
+	}
+	// Close is not called if Open fails, so the user is deleted here instead of waiting for its delete_after_date.
+	defer func() {
+		if resp.Diagnostics.HasError() {
+			deleteTemporaryDatabaseUser(ctx, connV2, projectID, username, &resp.Diagnostics)
+		}
+	}()
+
+	model.ConnectionString = types.StringNull()
+	if clusterName := model.ClusterName.ValueString(); clusterName != "" {
+		cluster, _, err := connV2.ClustersAPI.GetCluster(ctx, projectID, clusterName).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("error getting cluster %s for the connection string", clusterName), err.Error())
+			return
+		}
+		connectionString, err := ConnectionStringWithCredentials(cluster.ConnectionStrings.GetStandardSrv(), username, password)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("error building the connection string of cluster %s", clusterName), err.Error())
+			return
+		}
+		model.ConnectionString = types.StringValue(connectionString)
+	}
+
+	model.DeleteAfterHours = types.Int64Value(deleteAfterHours)
+	model.AuthDatabaseName = types.StringValue(temporaryUserAuthDatabaseName)
+	model.Password = types.StringValue(password)
+	model.DeleteAfterDate = types.StringValue(deleteAfterDate.Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteData, err := json.Marshal(closeData{ProjectID: projectID, Username: username})
+	if err != nil {
+		resp.Diagnostics.AddWarning("Failed to prepare temporary database user delete payload",
+			fmt.Sprintf("The user will not be deleted when the ephemeral resource is closed, Atlas deletes it on %s: %s", deleteAfterDate.Format(time.RFC3339), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, closeDataKey, deleteData)...)
+}
+
+func (r *databaseUserES) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	deleteData, diags := req.Private.GetKey(ctx, closeDataKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(deleteData) == 0 {
+		return
+	}
+	var data closeData
+	if err := json.Unmarshal(deleteData, &data); err != nil {
+		resp.Diagnostics.AddWarning("Failed to read temporary database user delete payload",
+			"Could not deserialize the delete data from private state. Atlas deletes the user on its delete_after_date.")
+		return
+	}
+	if r.EphemeralResourceData == nil || r.EphemeralResourceData.Client == nil {
+		return
+	}
+	log.Printf("[DEBUG] %s Close: deleting temporary database user %s", databaseUserResourceName, data.Username)
+	deleteTemporaryDatabaseUser(ctx, r.EphemeralResourceData.Client.AtlasV2, data.ProjectID, data.Username, &resp.Diagnostics)
+}
+
+// deleteTemporaryDatabaseUser deletes a temporary database user. Errors are warnings as Atlas deletes the user on its delete_after_date.
+func deleteTemporaryDatabaseUser(ctx context.Context, connV2 *admin.APIClient, projectID, username string, diags *diag.Diagnostics) {
+	httpResp, err := connV2.DatabaseUsersAPI.DeleteDatabaseUser(ctx, projectID, temporaryUserAuthDatabaseName, username).Execute()
+	if err != nil && !validate.StatusNotFound(httpResp) {
+		diags.AddWarning("Failed to delete temporary database user",
+			fmt.Sprintf("Atlas deletes the user %s on its delete_after_date: %s", username, err))
+	}
+}
+
+// NewMongoDBTemporaryDatabaseUser returns the request to create a temporary database user, reusing the database user resource conversion.
+// If scopes aren't set, the user is scoped to cluster_name.
+func NewMongoDBTemporaryDatabaseUser(ctx context.Context, model *TfDatabaseUserEphemeralModel, password string, deleteAfterDate time.Time) (*admin.CloudDatabaseUser, diag.Diagnostics) {
+	scopes := model.Scopes
+	if scopes.IsNull() {
+		scopes = types.SetValueMust(ScopeObjectType, nil)
+	}
+	dbUser, diags := NewMongoDBDatabaseUser(ctx, types.StringNull(), types.StringNull(), types.Int64Null(), &TfDatabaseUserModel{
+		ProjectID:        model.ProjectID,
+		Username:         model.Username,
+		AuthDatabaseName: types.StringValue(temporaryUserAuthDatabaseName),
+		Password:         types.StringValue(password),
+		Description:      model.Description,
+		Roles:            model.Roles,
+		Labels:           types.SetValueMust(LabelObjectType, nil),
+		Scopes:           scopes,
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+	if len(dbUser.GetScopes()) == 0 && model.ClusterName.ValueString() != "" {
+		dbUser.Scopes = &[]admin.UserScope{{Name: model.ClusterName.ValueString(), Type: "CLUSTER"}}
+	}
+	dbUser.DeleteAfterDate = &deleteAfterDate
+	return dbUser, diags
+}
+
+// ConnectionStringWithCredentials adds the username and password to a connection string, escaping them as needed.
+func ConnectionStringWithCredentials(connectionString, username, password string) (string, error) {
+	if connectionString == "" {
+		return "", errors.New("the cluster doesn't have a standard SRV connection string")
+	}
+	u, err := url.Parse(connectionString)
+	if err != nil {
+		return "", err
+	}
+	u.User = url.UserPassword(username, password)
+	return u.String(), nil
+}
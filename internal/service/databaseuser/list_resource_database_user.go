@@ -0,0 +1,75 @@
+package databaseuser
+
+import (
+	"context"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+var _ list.ListResourceWithConfigure = &databaseUserListRS{}
+
+func ListResource() list.ListResource {
+	return &databaseUserListRS{
+		LSCommon: config.LSCommon{
+			ResourceName: databaseUserResourceName,
+		},
+	}
+}
+
+type databaseUserListRS struct {
+	config.LSCommon
+}
+
+type TfDatabaseUserListModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+func (l *databaseUserListRS) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the project whose database users are listed.",
+			},
+		},
+	}
+}
+
+func (l *databaseUserListRS) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var model TfDatabaseUserListModel
+	diags := req.Config.Get(ctx, &model)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	projectID := model.ProjectID.ValueString()
+	dbUsers, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.CloudDatabaseUser], *http.Response, error) {
+		return l.Client.AtlasV2.DatabaseUsersAPI.ListDatabaseUsers(ctx, projectID).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		diags.AddError("error getting database user information", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	items := make([]config.ListItem, 0, len(dbUsers))
+	for i := range dbUsers {
+		dbUser := &dbUsers[i]
+		items = append(items, config.ListItem{
+			DisplayName: dbUser.DatabaseName + "/" + dbUser.Username,
+			Identity: map[string]string{
+				"project_id":         projectID,
+				"username":           dbUser.Username,
+				"auth_database_name": dbUser.DatabaseName,
+			},
+		})
+	}
+	l.StreamListItems(ctx, req, stream, Resource().(config.ImplementedResource), items)
+}
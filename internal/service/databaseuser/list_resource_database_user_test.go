@@ -0,0 +1,80 @@
+package databaseuser_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/databaseuser"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/unit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/atlas-sdk/v20250312023/mockadmin"
+)
+
+func TestListResource(t *testing.T) {
+	users := []admin.CloudDatabaseUser{
+		{GroupId: projectID, DatabaseName: "admin", Username: "user1"},
+		{GroupId: projectID, DatabaseName: "$external", Username: "user2"},
+	}
+	testCases := map[string]struct {
+		req      unit.ListRequest
+		expected []admin.CloudDatabaseUser
+	}{
+		"all pages": {
+			req:      unit.ListRequest{},
+			expected: users,
+		},
+		"include resource with limit": {
+			req:      unit.ListRequest{IncludeResource: true, Limit: 1},
+			expected: users[:1],
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := mockadmin.NewDatabaseUsersAPI(t)
+			m.EXPECT().ListDatabaseUsers(mock.Anything, projectID).Return(admin.ListDatabaseUsersApiRequest{ApiService: m}).Times(2)
+			m.EXPECT().ListDatabaseUsersExecute(mock.Anything).Return(&admin.PaginatedApiAtlasDatabaseUser{
+				Results:    users[:1],
+				TotalCount: conversion.IntPtr(2),
+			}, nil, nil).Once()
+			m.EXPECT().ListDatabaseUsersExecute(mock.Anything).Return(&admin.PaginatedApiAtlasDatabaseUser{
+				Results:    users[1:],
+				TotalCount: conversion.IntPtr(2),
+			}, nil, nil).Once()
+			if tc.req.IncludeResource {
+				for i := range tc.expected {
+					user := &tc.expected[i]
+					m.EXPECT().GetDatabaseUser(mock.Anything, projectID, user.DatabaseName, user.Username).Return(admin.GetDatabaseUserApiRequest{ApiService: m}).Once()
+					m.EXPECT().GetDatabaseUserExecute(mock.Anything).Return(user, nil, nil).Once()
+				}
+			}
+			tc.req.Attributes = map[string]any{"project_id": projectID}
+
+			results := unit.ListResources(t, databaseuser.ListResource(), databaseuser.Resource(), &admin.APIClient{DatabaseUsersAPI: m}, tc.req)
+			require.Len(t, results, len(tc.expected))
+			for i := range tc.expected {
+				user := &tc.expected[i]
+				unit.AssertDiagsOK(t, results[i].Diagnostics)
+				assert.Equal(t, user.DatabaseName+"/"+user.Username, results[i].DisplayName)
+				assert.Equal(t, map[string]string{"project_id": projectID, "username": user.Username, "auth_database_name": user.DatabaseName}, unit.ListResultIdentity(t, &results[i]))
+				if !tc.req.IncludeResource {
+					assert.True(t, results[i].Resource.Raw.IsNull())
+					continue
+				}
+				var id, authDatabaseName types.String
+				unit.AssertDiagsOK(t, results[i].Resource.GetAttribute(t.Context(), path.Root("id"), &id))
+				unit.AssertDiagsOK(t, results[i].Resource.GetAttribute(t.Context(), path.Root("auth_database_name"), &authDatabaseName))
+				assert.Equal(t, user.DatabaseName, authDatabaseName.ValueString(), "resource is populated by Read")
+				assert.Equal(t, conversion.EncodeStateID(map[string]string{
+					"project_id":         projectID,
+					"username":           user.Username,
+					"auth_database_name": user.DatabaseName,
+				}), id.ValueString())
+			}
+		})
+	}
+}
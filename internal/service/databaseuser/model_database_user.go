@@ -3,6 +3,7 @@ package databaseuser
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -46,7 +47,8 @@ func NewMongoDBDatabaseUser(ctx context.Context, statePasswordValue, stateDescri
 	}
 
 	if !plan.PasswordWo.IsNull() {
-		if statePasswordWoVersion.IsNull() || statePasswordWoVersion.ValueInt64() != plan.PasswordWoVersion.ValueInt64() {
+		// password_wo_rotated_at is unknown in the plan when the password_wo_rotation_days have passed, see PlanPasswordWoRotatedAt.
+		if statePasswordWoVersion.IsNull() || statePasswordWoVersion.ValueInt64() != plan.PasswordWoVersion.ValueInt64() || plan.PasswordWoRotatedAt.IsUnknown() {
 			result.Password = plan.PasswordWo.ValueStringPointer()
 		}
 	} else if statePasswordValue != plan.Password {
@@ -61,6 +63,37 @@ func NewMongoDBDatabaseUser(ctx context.Context, statePasswordValue, stateDescri
 	return &result, nil
 }
 
+// PlanPasswordWoRotatedAt returns the planned password_wo_rotated_at. It's unknown when password_wo will be sent to Atlas,
+// i.e. password_wo_version changes or password_wo_rotation_days have passed since the last password update.
+func PlanPasswordWoRotatedAt(state, plan *TfDatabaseUserModel, now time.Time) types.String {
+	if plan.PasswordWoVersion.IsNull() {
+		return types.StringNull()
+	}
+	if !plan.PasswordWoVersion.Equal(state.PasswordWoVersion) {
+		return types.StringUnknown()
+	}
+	if plan.PasswordWoRotationDays.IsNull() || plan.PasswordWoRotationDays.IsUnknown() {
+		return state.PasswordWoRotatedAt
+	}
+	// The last update time isn't known after import or if the user was created with a previous provider version.
+	rotatedAt, err := time.Parse(time.RFC3339, state.PasswordWoRotatedAt.ValueString())
+	if err != nil || !now.Before(rotatedAt.AddDate(0, 0, int(plan.PasswordWoRotationDays.ValueInt64()))) {
+		return types.StringUnknown()
+	}
+	return state.PasswordWoRotatedAt
+}
+
+// NewPasswordWoRotatedAt returns password_wo_rotated_at after a create or update, the current time if password_wo was sent to Atlas.
+func NewPasswordWoRotatedAt(plan *TfDatabaseUserModel, dbUserReq *admin.CloudDatabaseUser, now time.Time) types.String {
+	if !plan.PasswordWoRotatedAt.IsUnknown() {
+		return plan.PasswordWoRotatedAt
+	}
+	if plan.PasswordWo.IsNull() || dbUserReq.Password == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(now.UTC().Format(time.RFC3339))
+}
+
 func NewTfDatabaseUserModel(ctx context.Context, inModel *TfDatabaseUserModel, dbUser *admin.CloudDatabaseUser) (*TfDatabaseUserModel, diag.Diagnostics) {
 	rolesSet, diagnostic := types.SetValueFrom(ctx, RoleObjectType, NewTFRolesModel(dbUser.GetRoles()))
 	if diagnostic.HasError() {
@@ -104,6 +137,8 @@ func NewTfDatabaseUserModel(ctx context.Context, inModel *TfDatabaseUserModel, d
 			outModel.Password = inModel.Password
 		}
 		outModel.PasswordWoVersion = inModel.PasswordWoVersion
+		outModel.PasswordWoRotationDays = inModel.PasswordWoRotationDays
+		outModel.PasswordWoRotatedAt = inModel.PasswordWoRotatedAt
 		if outModel.Description.Equal(types.StringValue("")) && inModel.Description.IsNull() {
 			outModel.Description = types.StringNull()
 		}
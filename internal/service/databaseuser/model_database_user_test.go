@@ -3,6 +3,7 @@ package databaseuser_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -168,6 +169,13 @@ func newPasswordWoTestModel(passwordWo string, version int64) databaseuser.TfDat
 	}
 }
 
+func newPasswordWoRotationTestModel(passwordWo string, version int64, rotatedAt types.String) databaseuser.TfDatabaseUserModel {
+	model := newPasswordWoTestModel(passwordWo, version)
+	model.PasswordWoRotationDays = types.Int64Value(30)
+	model.PasswordWoRotatedAt = rotatedAt
+	return model
+}
+
 func newPasswordWoExpectedResult(password *string) *admin.CloudDatabaseUser {
 	return &admin.CloudDatabaseUser{
 		GroupId:      projectID,
@@ -206,6 +214,12 @@ func TestNewMongoDBDatabaseUserPasswordWo(t *testing.T) {
 			passwordWoStateValue: types.Int64Value(1),
 			expectedResult:       newPasswordWoExpectedResult(nil),
 		},
+		{
+			name:                 "password_wo sets password when rotation is due on UPDATE",
+			tfDatabaseUserModel:  newPasswordWoRotationTestModel("rotated-password", 1, types.StringUnknown()),
+			passwordWoStateValue: types.Int64Value(1),
+			expectedResult:       newPasswordWoExpectedResult(new("rotated-password")),
+		},
 	}
 
 	for i, tc := range testCases {
@@ -220,6 +234,84 @@ func TestNewMongoDBDatabaseUserPasswordWo(t *testing.T) {
 	}
 }
 
+func TestPlanPasswordWoRotatedAt(t *testing.T) {
+	var (
+		now       = time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+		rotatedAt = types.StringValue("2026-03-01T12:00:00Z")
+	)
+	testCases := map[string]struct {
+		expected types.String
+		state    databaseuser.TfDatabaseUserModel
+		plan     databaseuser.TfDatabaseUserModel
+	}{
+		"password_wo not used": {
+			state:    databaseuser.TfDatabaseUserModel{},
+			plan:     databaseuser.TfDatabaseUserModel{},
+			expected: types.StringNull(),
+		},
+		"version changes": {
+			state:    newPasswordWoTestModel("", 1),
+			plan:     newPasswordWoTestModel("", 2),
+			expected: types.StringUnknown(),
+		},
+		"version unchanged without rotation": {
+			state:    newPasswordWoRotationTestModel("", 1, rotatedAt),
+			plan:     newPasswordWoTestModel("", 1),
+			expected: rotatedAt,
+		},
+		"rotation not due": {
+			state:    newPasswordWoRotationTestModel("", 1, types.StringValue("2026-03-02T12:00:00Z")),
+			plan:     newPasswordWoRotationTestModel("", 1, types.StringUnknown()),
+			expected: types.StringValue("2026-03-02T12:00:00Z"),
+		},
+		"rotation due": {
+			state:    newPasswordWoRotationTestModel("", 1, rotatedAt),
+			plan:     newPasswordWoRotationTestModel("", 1, types.StringUnknown()),
+			expected: types.StringUnknown(),
+		},
+		"rotation without last update time": {
+			state:    newPasswordWoTestModel("", 1),
+			plan:     newPasswordWoRotationTestModel("", 1, types.StringUnknown()),
+			expected: types.StringUnknown(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, databaseuser.PlanPasswordWoRotatedAt(&tc.state, &tc.plan, now))
+		})
+	}
+}
+
+func TestNewPasswordWoRotatedAt(t *testing.T) {
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		plan      databaseuser.TfDatabaseUserModel
+		dbUserReq *admin.CloudDatabaseUser
+		expected  types.String
+	}{
+		"password sent": {
+			plan:      newPasswordWoRotationTestModel("password", 1, types.StringUnknown()),
+			dbUserReq: &admin.CloudDatabaseUser{Password: new("password")},
+			expected:  types.StringValue("2026-03-31T12:00:00Z"),
+		},
+		"password not sent": {
+			plan:      newPasswordWoRotationTestModel("password", 1, types.StringValue("2026-03-02T12:00:00Z")),
+			dbUserReq: &admin.CloudDatabaseUser{},
+			expected:  types.StringValue("2026-03-02T12:00:00Z"),
+		},
+		"password_wo not used": {
+			plan:      databaseuser.TfDatabaseUserModel{Password: types.StringValue(password), PasswordWoRotatedAt: types.StringUnknown()},
+			dbUserReq: &admin.CloudDatabaseUser{Password: new(password)},
+			expected:  types.StringNull(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, databaseuser.NewPasswordWoRotatedAt(&tc.plan, tc.dbUserReq, now))
+		})
+	}
+}
+
 func TestNewTfDatabaseUserModel(t *testing.T) {
 	testCases := []struct {
 		expectedResult  *databaseuser.TfDatabaseUserModel
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -30,6 +31,8 @@ const (
 
 var _ resource.ResourceWithConfigure = &databaseUserRS{}
 var _ resource.ResourceWithImportState = &databaseUserRS{}
+var _ resource.ResourceWithIdentity = &databaseUserRS{}
+var _ resource.ResourceWithModifyPlan = &databaseUserRS{}
 
 type databaseUserRS struct {
 	config.RSCommon
@@ -44,21 +47,23 @@ func Resource() resource.Resource {
 }
 
 type TfDatabaseUserModel struct {
-	ID                types.String `tfsdk:"id"`
-	ProjectID         types.String `tfsdk:"project_id"`
-	AuthDatabaseName  types.String `tfsdk:"auth_database_name"`
-	Username          types.String `tfsdk:"username"`
-	Password          types.String `tfsdk:"password"`
-	PasswordWo        types.String `tfsdk:"password_wo"`
-	X509Type          types.String `tfsdk:"x509_type"`
-	OIDCAuthType      types.String `tfsdk:"oidc_auth_type"`
-	LDAPAuthType      types.String `tfsdk:"ldap_auth_type"`
-	AWSIAMType        types.String `tfsdk:"aws_iam_type"`
-	Description       types.String `tfsdk:"description"`
-	Roles             types.Set    `tfsdk:"roles"`
-	Labels            types.Set    `tfsdk:"labels"`
-	Scopes            types.Set    `tfsdk:"scopes"`
-	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+	ID                     types.String `tfsdk:"id"`
+	ProjectID              types.String `tfsdk:"project_id"`
+	AuthDatabaseName       types.String `tfsdk:"auth_database_name"`
+	Username               types.String `tfsdk:"username"`
+	Password               types.String `tfsdk:"password"`
+	PasswordWo             types.String `tfsdk:"password_wo"`
+	X509Type               types.String `tfsdk:"x509_type"`
+	OIDCAuthType           types.String `tfsdk:"oidc_auth_type"`
+	LDAPAuthType           types.String `tfsdk:"ldap_auth_type"`
+	AWSIAMType             types.String `tfsdk:"aws_iam_type"`
+	Description            types.String `tfsdk:"description"`
+	Roles                  types.Set    `tfsdk:"roles"`
+	Labels                 types.Set    `tfsdk:"labels"`
+	Scopes                 types.Set    `tfsdk:"scopes"`
+	PasswordWoRotatedAt    types.String `tfsdk:"password_wo_rotated_at"`
+	PasswordWoVersion      types.Int64  `tfsdk:"password_wo_version"`
+	PasswordWoRotationDays types.Int64  `tfsdk:"password_wo_rotation_days"`
 }
 
 type TfRoleModel struct {
@@ -152,6 +157,18 @@ func (r *databaseUserRS) Schema(ctx context.Context, req resource.SchemaRequest,
 					),
 				},
 			},
+			"password_wo_rotation_days": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(
+						path.MatchRelative().AtParent().AtName("password_wo"),
+					),
+				},
+			},
+			"password_wo_rotated_at": schema.StringAttribute{
+				Computed: true,
+			},
 			"description": schema.StringAttribute{
 				Optional: true,
 			},
@@ -234,6 +251,26 @@ func (r *databaseUserRS) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *databaseUserRS) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(schemaResp.Schema, "project_id", "username", "auth_database_name")
+}
+
+func (r *databaseUserRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// password_wo_rotated_at is unknown on create and there is nothing to plan on delete.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var plan, state *TfDatabaseUserModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_wo_rotated_at"), PlanPasswordWoRotatedAt(state, plan, time.Now()))...)
+}
+
 func (r *databaseUserRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan *TfDatabaseUserModel
 	var configModel *TfDatabaseUserModel
@@ -271,6 +308,7 @@ func (r *databaseUserRS) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	dbUserModel.PasswordWoRotatedAt = NewPasswordWoRotatedAt(plan, dbUserReq, time.Now())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &dbUserModel)...)
 	if resp.Diagnostics.HasError() {
@@ -348,6 +386,7 @@ func (r *databaseUserRS) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	dbUserModel.PasswordWoRotatedAt = NewPasswordWoRotatedAt(plan, dbUserReq, time.Now())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &dbUserModel)...)
 	if resp.Diagnostics.HasError() {
@@ -39,13 +39,13 @@ var (
 		ImportStateVerify:       true,
 		ImportStateVerifyIgnore: []string{"password"},
 	}
-	// Atlas does not return password_wo_version, so it is null after import.
+	// Atlas does not return password_wo_version or the password rotation attributes, so they are null after import.
 	importStepWriteOnly = resource.TestStep{
 		ResourceName:            resourceName,
 		ImportStateIdFunc:       importStateIDFunc(resourceName),
 		ImportState:             true,
 		ImportStateVerify:       true,
-		ImportStateVerifyIgnore: []string{"password", "password_wo_version"},
+		ImportStateVerifyIgnore: []string{"password", "password_wo_version", "password_wo_rotation_days", "password_wo_rotated_at"},
 	}
 )
 
@@ -574,6 +574,39 @@ func TestAccDatabaseUser_withPasswordWriteOnly(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseUser_withGeneratedPasswordRotation(t *testing.T) {
+	var (
+		projectID = acc.ProjectIDExecution(t)
+		username  = acc.RandomName()
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0), // write-only attributes require Terraform 1.11+
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configDatabaseUserGeneratedPassword(projectID, username, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_rotation_days", "30"),
+					resource.TestCheckResourceAttrSet(resourceName, "password_wo_rotated_at"),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+				),
+			},
+			{
+				// A new password is generated in every run but it's only sent to Atlas when the rotation is due.
+				Config:   configDatabaseUserGeneratedPassword(projectID, username, 30),
+				PlanOnly: true,
+			},
+			importStepWriteOnly,
+		},
+	})
+}
+
 func TestAccDatabaseUser_migrateToPasswordWriteOnly(t *testing.T) {
 	var (
 		projectID = acc.ProjectIDExecution(t)
@@ -719,3 +752,23 @@ func configDatabaseUserPasswordWoVersionOnly(projectID, username string) string
 		}
 	`, projectID, username)
 }
+
+func configDatabaseUserGeneratedPassword(projectID, username string, rotationDays int) string {
+	return fmt.Sprintf(`
+		ephemeral "mongodbatlas_database_user_credentials" "test" {}
+
+		resource "mongodbatlas_database_user" "test" {
+			project_id                = %[1]q
+			username                  = %[2]q
+			password_wo               = ephemeral.mongodbatlas_database_user_credentials.test.password
+			password_wo_version       = 1
+			password_wo_rotation_days = %[3]d
+			auth_database_name        = "admin"
+
+			roles {
+				role_name     = "atlasAdmin"
+				database_name = "admin"
+			}
+		}
+	`, projectID, username, rotationDays)
+}
@@ -0,0 +1,111 @@
+package databaseusercredentials
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	// ResourceTypeName is the Terraform type name for the database user credentials ephemeral resource.
+	ResourceTypeName = "database_user_credentials"
+
+	defaultPasswordLength = 32
+	minPasswordLength     = 12
+	maxPasswordLength     = 128
+
+	lowerChars = "abcdefghijklmnopqrstuvwxyz"
+	upperChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitChars = "0123456789"
+	// specialChars are unreserved in URIs so the password can be used in connection strings without encoding.
+	specialChars = "-_.~"
+)
+
+var _ ephemeral.EphemeralResource = &ER{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ER{}
+
+type ER struct {
+	config.ESCommon
+}
+
+func New() ephemeral.EphemeralResource {
+	return &ER{
+		ESCommon: config.ESCommon{
+			ResourceName: ResourceTypeName,
+		},
+	}
+}
+
+func (r *ER) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = EphemeralResourceSchema(ctx)
+}
+
+func (r *ER) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model TFModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	length := defaultPasswordLength
+	if !model.Length.IsNull() && !model.Length.IsUnknown() {
+		length = int(model.Length.ValueInt64())
+	}
+	special := model.SpecialCharacters.IsNull() || model.SpecialCharacters.ValueBool()
+
+	password, err := GeneratePassword(length, special)
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating database user password", err.Error())
+		return
+	}
+
+	model.Length = types.Int64Value(int64(length))
+	model.SpecialCharacters = types.BoolValue(special)
+	model.Password = types.StringValue(password)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
+
+// GeneratePassword returns a random password with at least one character of each class: lowercase, uppercase, digit and,
+// if special is true, special characters. The password meets the Atlas password requirements for any length of at least 8.
+func GeneratePassword(length int, special bool) (string, error) {
+	classes := []string{lowerChars, upperChars, digitChars}
+	if special {
+		classes = append(classes, specialChars)
+	}
+	allChars := strings.Join(classes, "")
+	password := make([]byte, length)
+	for i := range password {
+		chars := allChars
+		if i < len(classes) {
+			chars = classes[i]
+		}
+		n, err := randomInt(len(chars))
+		if err != nil {
+			return "", err
+		}
+		password[i] = chars[n]
+	}
+	// Shuffle so the required characters aren't always at the start.
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomInt(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+	return string(password), nil
+}
+
+func randomInt(maxValue int) (int, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(maxValue)))
+	if err != nil {
+		return 0, err
+	}
+	return int(n.Int64()), nil
+}
@@ -0,0 +1,40 @@
+package databaseusercredentials
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func EphemeralResourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"length": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(minPasswordLength, maxPasswordLength),
+				},
+				MarkdownDescription: fmt.Sprintf("Number of characters of the password, between %d and %d. Defaults to `%d`.", minPasswordLength, maxPasswordLength, defaultPasswordLength),
+			},
+			"special_characters": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("If true, the password includes the special characters `%s`, which don't need to be encoded in connection strings. Defaults to `true`.", specialChars),
+			},
+			"password": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The generated password. It has at least one lowercase letter, one uppercase letter and one digit, and one special character if `special_characters` is true.",
+			},
+		},
+	}
+}
+
+type TFModel struct {
+	Password          types.String `tfsdk:"password"`
+	Length            types.Int64  `tfsdk:"length"`
+	SpecialCharacters types.Bool   `tfsdk:"special_characters"`
+}
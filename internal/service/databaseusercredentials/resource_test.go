@@ -0,0 +1,105 @@
+package databaseusercredentials_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/databaseusercredentials"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+var versionChecks = []tfversion.TerraformVersionCheck{
+	tfversion.SkipBelow(tfversion.Version1_10_0),
+}
+
+func TestAccDatabaseUserCredentials_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks:   versionChecks,
+		ProtoV6ProviderFactories: acc.ProtoV6FactoriesWithEcho(),
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic(""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.credentials", tfjsonpath.New("data").AtMapKey("length"), knownvalue.Int64Exact(32)),
+					statecheck.ExpectKnownValue("echo.credentials", tfjsonpath.New("data").AtMapKey("special_characters"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("echo.credentials", tfjsonpath.New("data").AtMapKey("password"), knownvalue.StringRegexp(regexp.MustCompile(`^[a-zA-Z0-9._~-]{32}$`))),
+				},
+			},
+			{
+				Config: configBasic("length = 16\nspecial_characters = false"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.credentials", tfjsonpath.New("data").AtMapKey("password"), knownvalue.StringRegexp(regexp.MustCompile(`^[a-zA-Z0-9]{16}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDatabaseUserCredentials_invalidLength(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks:   versionChecks,
+		ProtoV6ProviderFactories: acc.ProtoV6FactoriesWithEcho(),
+		Steps: []resource.TestStep{
+			{
+				Config:      configBasic("length = 8"),
+				ExpectError: regexp.MustCompile(`Attribute length value must be between 12 and 128`),
+			},
+		},
+	})
+}
+
+func TestGeneratePassword(t *testing.T) {
+	testCases := map[string]struct {
+		length  int
+		special bool
+	}{
+		"minimum length with special characters": {length: 12, special: true},
+		"default length":                         {length: 32, special: true},
+		"without special characters":             {length: 32, special: false},
+		"maximum length":                         {length: 128, special: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			password, err := databaseusercredentials.GeneratePassword(tc.length, tc.special)
+			require.NoError(t, err)
+			assert.Len(t, password, tc.length)
+			assert.Regexp(t, `[a-z]`, password)
+			assert.Regexp(t, `[A-Z]`, password)
+			assert.Regexp(t, `[0-9]`, password)
+			assert.Equal(t, tc.special, strings.ContainsAny(password, "-_.~"))
+			assert.Regexp(t, `^[a-zA-Z0-9._~-]+$`, password)
+		})
+	}
+}
+
+func TestGeneratePasswordIsRandom(t *testing.T) {
+	first, err := databaseusercredentials.GeneratePassword(32, true)
+	require.NoError(t, err)
+	second, err := databaseusercredentials.GeneratePassword(32, true)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
+
+func configBasic(extra string) string {
+	return fmt.Sprintf(`
+ephemeral "mongodbatlas_database_user_credentials" "test" {
+  %s
+}
+
+provider "echo" {
+  data = ephemeral.mongodbatlas_database_user_credentials.test
+}
+
+resource "echo" "credentials" {}
+`, extra)
+}
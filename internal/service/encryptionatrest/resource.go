@@ -11,6 +11,7 @@ import (
 	"go.mongodb.org/atlas-sdk/v20250312023/admin"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,9 +20,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/concurrency"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/retrystrategy"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
@@ -39,6 +42,8 @@ const (
 
 var _ resource.ResourceWithConfigure = &encryptionAtRestRS{}
 var _ resource.ResourceWithImportState = &encryptionAtRestRS{}
+var _ resource.ResourceWithIdentity = &encryptionAtRestRS{}
+var _ config.ResourceWithImportStateFromIdentity = &encryptionAtRestRS{}
 
 func Resource() resource.Resource {
 	return &encryptionAtRestRS{
@@ -279,6 +284,12 @@ func (r *encryptionAtRestRS) Schema(ctx context.Context, req resource.SchemaRequ
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *encryptionAtRestRS) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(schemaResp.Schema, "project_id")
+}
+
 func (r *encryptionAtRestRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var encryptionAtRestPlan *TfEncryptionAtRestRSModel
 	var encryptionAtRestConfig *TfEncryptionAtRestRSModel
@@ -291,6 +302,7 @@ func (r *encryptionAtRestRS) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	projectID := encryptionAtRestPlan.ProjectID.ValueString()
+	defer concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeEncryptionAtRest))()
 	encryptionAtRestReq := &admin.EncryptionAtRest{}
 	if !encryptionAtRestPlan.EnabledForSearchNodes.IsNull() {
 		encryptionAtRestReq.EnabledForSearchNodes = encryptionAtRestPlan.EnabledForSearchNodes.ValueBoolPointer()
@@ -414,6 +426,7 @@ func (r *encryptionAtRestRS) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 	projectID := encryptionAtRestState.ProjectID.ValueString()
+	defer concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeEncryptionAtRest))()
 	atlasEncryptionAtRest, _, err := connV2.EncryptionAtRestUsingCustomerKeyManagementAPI.GetEncryptionAtRest(ctx, projectID).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("error when getting encryption at rest resource during update", fmt.Sprintf(project.ErrorProjectRead, projectID, err.Error()))
@@ -446,6 +459,7 @@ func (r *encryptionAtRestRS) Delete(ctx context.Context, req resource.DeleteRequ
 	enabled := false
 	connV2 := r.Client.AtlasV2
 	projectID := encryptionAtRestState.ProjectID.ValueString()
+	defer concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeEncryptionAtRest))()
 
 	_, _, err := connV2.EncryptionAtRestUsingCustomerKeyManagementAPI.GetEncryptionAtRest(ctx, projectID).Execute()
 	if err != nil {
@@ -470,6 +484,11 @@ func (r *encryptionAtRestRS) ImportState(ctx context.Context, req resource.Impor
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// ImportStateFromIdentity sets the id instead of project_id so Read handles it as an import.
+func (r *encryptionAtRestRS) ImportStateFromIdentity(ctx context.Context, identity map[string]string, state *tfsdk.State) diag.Diagnostics {
+	return state.SetAttribute(ctx, path.Root("id"), identity["project_id"])
+}
+
 func hasGcpKmsConfigChanged(gcpKmsConfigsPlan, gcpKmsConfigsState []TFGcpKmsConfigModel) bool {
 	return !reflect.DeepEqual(gcpKmsConfigsPlan, gcpKmsConfigsState)
 }
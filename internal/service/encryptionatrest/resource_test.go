@@ -84,6 +84,37 @@ func basicTestCaseAWS(tb testing.TB, useDatasource, useRequirePrivateNetworking,
 	}
 }
 
+func TestAccEncryptionAtRest_importWithIdentity(t *testing.T) {
+	var (
+		projectID = acc.ProjectIDExecution(t)
+
+		awsIAMRoleName       = acc.RandomIAMRole()
+		awsIAMRolePolicyName = fmt.Sprintf("%s-policy", awsIAMRoleName)
+
+		awsKms = admin.AWSKMSConfiguration{
+			Enabled:                  new(true),
+			CustomerMasterKeyID:      conversion.StringPtr(os.Getenv("AWS_CUSTOMER_MASTER_KEY_ID")),
+			Region:                   conversion.StringPtr(conversion.AWSRegionToMongoDBRegion(os.Getenv("AWS_REGION"))),
+			RequirePrivateNetworking: new(false),
+		}
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckAwsEnv(t) },
+		ExternalProviders:        acc.ExternalProvidersOnlyAWS(),
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		TerraformVersionChecks:   acc.IdentityVersionChecks(),
+		CheckDestroy:             acc.EARDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acc.ConfigAwsKmsWithRole(projectID, awsIAMRoleName, awsIAMRolePolicyName, &awsKms, false, false, false),
+				Check:  checkEARResourceAWS(projectID, false, acc.ConvertToAwsKmsEARAttrMap(&awsKms)),
+			},
+			acc.ImportStepWithIdentity(resourceName),
+		},
+	})
+}
+
 func TestAccEncryptionAtRest_basicAzure(t *testing.T) {
 	var (
 		projectID = acc.ProjectIDExecution(t)
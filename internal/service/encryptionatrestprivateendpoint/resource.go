@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/cleanup"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/concurrency"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/retrystrategy"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
@@ -29,6 +30,7 @@ const (
 
 var _ resource.ResourceWithConfigure = &encryptionAtRestPrivateEndpointRS{}
 var _ resource.ResourceWithImportState = &encryptionAtRestPrivateEndpointRS{}
+var _ resource.ResourceWithIdentity = &encryptionAtRestPrivateEndpointRS{}
 
 func Resource() resource.Resource {
 	return &encryptionAtRestPrivateEndpointRS{
@@ -47,6 +49,10 @@ func (r *encryptionAtRestPrivateEndpointRS) Schema(ctx context.Context, req reso
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *encryptionAtRestPrivateEndpointRS) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(ResourceSchema(ctx), "project_id", "cloud_provider", "id")
+}
+
 func (r *encryptionAtRestPrivateEndpointRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var earPrivateEndpointPlan TFEarPrivateEndpointModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &earPrivateEndpointPlan)...)
@@ -58,7 +64,9 @@ func (r *encryptionAtRestPrivateEndpointRS) Create(ctx context.Context, req reso
 	connV2 := r.Client.AtlasV2
 	projectID := earPrivateEndpointPlan.ProjectID.ValueString()
 	cloudProvider := earPrivateEndpointPlan.CloudProvider.ValueString()
+	unlock := concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeEncryptionAtRest))
 	createResp, _, err := connV2.EncryptionAtRestUsingCustomerKeyManagementAPI.CreateRestPrivateEndpoint(ctx, projectID, cloudProvider, privateEndpointReq).Execute()
+	unlock()
 	if err != nil {
 		resp.Diagnostics.AddError("error creating resource", err.Error())
 		return
@@ -71,6 +79,7 @@ func (r *encryptionAtRestPrivateEndpointRS) Create(ctx context.Context, req reso
 
 	finalResp, err := waitStateTransition(ctx, projectID, cloudProvider, createResp.GetId(), connV2.EncryptionAtRestUsingCustomerKeyManagementAPI, createTimeout)
 	err = cleanup.HandleCreateTimeout(earPrivateEndpointPlan.DeleteOnCreateTimeout.ValueBool(), err, func(ctxCleanup context.Context) error {
+		defer concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeEncryptionAtRest))()
 		cleanResp, cleanErr := connV2.EncryptionAtRestUsingCustomerKeyManagementAPI.RequestPrivateEndpointDeletion(ctxCleanup, projectID, cloudProvider, createResp.GetId()).Execute()
 		if validate.StatusNotFound(cleanResp) {
 			return nil
@@ -155,7 +164,10 @@ func (r *encryptionAtRestPrivateEndpointRS) Delete(ctx context.Context, req reso
 	projectID := earPrivateEndpointState.ProjectID.ValueString()
 	cloudProvider := earPrivateEndpointState.CloudProvider.ValueString()
 	endpointID := earPrivateEndpointState.ID.ValueString()
-	if _, err := connV2.EncryptionAtRestUsingCustomerKeyManagementAPI.RequestPrivateEndpointDeletion(ctx, projectID, cloudProvider, endpointID).Execute(); err != nil {
+	unlock := concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeEncryptionAtRest))
+	_, err := connV2.EncryptionAtRestUsingCustomerKeyManagementAPI.RequestPrivateEndpointDeletion(ctx, projectID, cloudProvider, endpointID).Execute()
+	unlock()
+	if err != nil {
 		resp.Diagnostics.AddError("error deleting resource", err.Error())
 		return
 	}
@@ -7,13 +7,13 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/schemafunc"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 	"github.com/spf13/cast"
 	"go.mongodb.org/realm/realm"
@@ -105,46 +105,16 @@ func Resource() *schema.Resource {
 				Computed: true,
 			},
 			"config_match": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				DiffSuppressFunc: func(k, oldAttr, newAttr string, d *schema.ResourceData) bool {
-					var j, j2 any
-					if err := json.Unmarshal([]byte(oldAttr), &j); err != nil {
-						log.Printf("[ERROR] json.Unmarshal %v", err)
-						return false
-					}
-					if err := json.Unmarshal([]byte(newAttr), &j2); err != nil {
-						log.Printf("[ERROR] json.Unmarshal %v", err)
-						return false
-					}
-					if !reflect.DeepEqual(&j, &j2) {
-						return false
-					}
-
-					return true
-				},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: schemafunc.DiffSuppressJSON,
 			},
 			"config_project": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				DiffSuppressFunc: func(k, oldAttr, newAttr string, d *schema.ResourceData) bool {
-					var j, j2 any
-					if err := json.Unmarshal([]byte(oldAttr), &j); err != nil {
-						log.Printf("[ERROR] json.Unmarshal %v", err)
-						return false
-					}
-					if err := json.Unmarshal([]byte(newAttr), &j2); err != nil {
-						log.Printf("[ERROR] json.Unmarshal %v", err)
-						return false
-					}
-					if !reflect.DeepEqual(&j, &j2) {
-						return false
-					}
-
-					return true
-				},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: schemafunc.DiffSuppressJSON,
 			},
 			"config_full_document": {
 				Type:     schema.TypeBool,
@@ -16,8 +16,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spf13/cast"
 
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/concurrency"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/policycheck"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 )
@@ -139,7 +141,9 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		}
 	}
 
+	unlock := concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeNetworking))
 	container, _, err := connV2.NetworkPeeringAPI.CreateGroupContainer(ctx, projectID, containerRequest).Execute()
+	unlock()
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorContainterCreate, err))
 	}
@@ -149,7 +153,7 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		"container_id": container.GetId(),
 	}))
 
-	return resourceRead(ctx, d, meta)
+	return append(policycheck.WarningsSDKv2(ctx, meta, projectID, policycheck.ActionPrivateNetworkingModify), resourceRead(ctx, d, meta)...)
 }
 
 func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -249,11 +253,13 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 			}
 		}
 	}
+	unlock := concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeNetworking))
 	_, _, err := connV2.NetworkPeeringAPI.UpdateGroupContainer(ctx, projectID, containerID, params).Execute()
+	unlock()
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorContainerUpdate, containerID, err))
 	}
-	return resourceRead(ctx, d, meta)
+	return append(policycheck.WarningsSDKv2(ctx, meta, projectID, policycheck.ActionPrivateNetworkingModify), resourceRead(ctx, d, meta)...)
 }
 
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -337,7 +343,9 @@ func resourceRefreshFunc(ctx context.Context, d *schema.ResourceData, client *ad
 		}
 
 		// Atlas Delete is called inside refresh to retry when error: HTTP 409 Conflict (Error code: "CONTAINERS_IN_USE").
+		unlock := concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeNetworking))
 		_, err = client.NetworkPeeringAPI.DeleteGroupContainer(ctx, projectID, containerID).Execute()
+		unlock()
 		if err != nil {
 			return nil, "provisioned_container", nil
 		}
@@ -12,7 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/cleanup"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/concurrency"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/policycheck"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/networkcontainer"
@@ -251,7 +253,9 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		peerRequest.SetVnetName(vnetName.(string))
 	}
 
+	unlock := concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeNetworking))
 	peer, _, err := conn.NetworkPeeringAPI.CreateGroupPeer(ctx, projectID, peerRequest).Execute()
+	unlock()
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorPeersCreate, err))
 	}
@@ -271,6 +275,7 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		deleteOnCreateTimeout = v.(bool)
 	}
 	errWait = cleanup.HandleCreateTimeout(deleteOnCreateTimeout, errWait, func(ctxCleanup context.Context) error {
+		defer concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeNetworking))()
 		_, _, errCleanup := conn.NetworkPeeringAPI.DeleteGroupPeer(ctxCleanup, projectID, peerID).Execute()
 		return errCleanup
 	})
@@ -284,7 +289,7 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		"provider_name": providerName,
 	}))
 
-	return resourceRead(ctx, d, meta)
+	return append(policycheck.WarningsSDKv2(ctx, meta, projectID, policycheck.ActionPrivateNetworkingModify), resourceRead(ctx, d, meta)...)
 }
 
 func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -472,7 +477,9 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	}
 	fmt.Print(peerConn.GetStatus())
 
+	unlock := concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeNetworking))
 	_, _, err := conn.NetworkPeeringAPI.UpdateGroupPeer(ctx, projectID, peerID, peer).Execute()
+	unlock()
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorPeersUpdate, peerID, err))
 	}
@@ -491,7 +498,7 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diag.FromErr(fmt.Errorf(errorPeersCreate, err))
 	}
 
-	return resourceRead(ctx, d, meta)
+	return append(policycheck.WarningsSDKv2(ctx, meta, projectID, policycheck.ActionPrivateNetworkingModify), resourceRead(ctx, d, meta)...)
 }
 
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -500,7 +507,9 @@ func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	projectID := ids["project_id"]
 	peerID := ids["peer_id"]
 
+	unlock := concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeNetworking))
 	_, _, err := conn.NetworkPeeringAPI.DeleteGroupPeer(ctx, projectID, peerID).Execute()
+	unlock()
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorPeersDelete, peerID, err))
 	}
@@ -425,7 +425,7 @@ func getAtlasV2Connection(ctx context.Context, d *schema.ResourceData, meta any)
 			PrivateKey: privateKey,
 			BaseURL:    currentClient.BaseURL,
 		}
-		if newClient, err := config.NewClient(c, currentClient.TerraformVersion); err == nil {
+		if newClient, err := config.NewClient(c, currentClient.TerraformVersion, currentClient.RateLimit); err == nil {
 			return newClient.AtlasV2
 		}
 	}
@@ -462,7 +462,7 @@ func newSAClient(ctx context.Context, d *schema.ResourceData, clientID, secretVa
 		ClientSecret: secretValue,
 		BaseURL:      currentClient.BaseURL,
 	}
-	newClient, err := config.NewClient(c, currentClient.TerraformVersion)
+	newClient, err := config.NewClient(c, currentClient.TerraformVersion, currentClient.RateLimit)
 	if err != nil {
 		return nil
 	}
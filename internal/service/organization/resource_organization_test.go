@@ -476,7 +476,7 @@ func getTestClientWithNewOrgCreds(rs *terraform.ResourceState) (*admin.APIClient
 			PrivateKey: privateKey,
 			BaseURL:    acc.MongoDBClient.BaseURL,
 		}
-		client, err := config.NewClient(c, acc.MongoDBClient.TerraformVersion)
+		client, err := config.NewClient(c, acc.MongoDBClient.TerraformVersion, nil)
 		if err != nil {
 			return nil, fmt.Errorf("error creating client with PAK credentials: %s", err)
 		}
@@ -491,7 +491,7 @@ func getTestClientWithNewOrgCreds(rs *terraform.ResourceState) (*admin.APIClient
 			ClientSecret: clientSecret,
 			BaseURL:      acc.MongoDBClient.BaseURL,
 		}
-		client, err := config.NewClient(c, acc.MongoDBClient.TerraformVersion)
+		client, err := config.NewClient(c, acc.MongoDBClient.TerraformVersion, nil)
 		if err != nil {
 			return nil, fmt.Errorf("error creating client with SA credentials: %s", err)
 		}
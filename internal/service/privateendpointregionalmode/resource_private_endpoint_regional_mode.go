@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/concurrency"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/advancedcluster"
@@ -100,7 +101,9 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	settingParam := admin.ProjectSettingItem{
 		Enabled: enabled,
 	}
+	unlock := concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeNetworking))
 	_, resp, err := conn.PrivateEndpointServicesAPI.ToggleRegionalEndpointMode(ctx, projectID, &settingParam).Execute()
+	unlock()
 	if err != nil {
 		if validate.StatusNotFound(resp) {
 			return nil
@@ -13,8 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/cleanup"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/concurrency"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/policycheck"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 
@@ -164,7 +166,9 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		request.SupportedRemoteRegions = &regions
 	}
 
+	unlock := concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeNetworking))
 	privateEndpoint, _, err := connV2.PrivateEndpointServicesAPI.CreatePrivateEndpointService(ctx, projectID, request).Execute()
+	unlock()
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorPrivateLinkEndpointsCreate, err))
 	}
@@ -176,6 +180,7 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		deleteOnCreateTimeout = v.(bool)
 	}
 	errWait = cleanup.HandleCreateTimeout(deleteOnCreateTimeout, errWait, func(ctxCleanup context.Context) error {
+		defer concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeNetworking))()
 		_, errCleanup := connV2.PrivateEndpointServicesAPI.DeletePrivateEndpointService(ctxCleanup, projectID, providerName, privateEndpoint.GetId()).Execute()
 		return errCleanup
 	})
@@ -190,7 +195,7 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		"region":          region,
 	}))
 
-	return resourceRead(ctx, d, meta)
+	return append(policycheck.WarningsSDKv2(ctx, meta, projectID, policycheck.ActionPrivateNetworkingModify), resourceRead(ctx, d, meta)...)
 }
 
 // resourceUpdate handles changes to supported_remote_regions. Most attributes use ForceNew so they
@@ -210,7 +215,9 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		CloudProvider:          providerName,
 		SupportedRemoteRegions: &regions,
 	}
+	unlock := concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeNetworking))
 	_, _, err := connV2.PrivateEndpointServicesAPI.UpdatePrivateEndpointService(ctx, projectID, privateLinkID, updateRequest).Execute()
+	unlock()
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorPrivateLinkEndpointsUpdate, privateLinkID, err))
 	}
@@ -223,7 +230,7 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	if err := d.Set("supported_remote_regions", regions); err != nil {
 		return diag.FromErr(fmt.Errorf(ErrorPrivateLinkEndpointsSetting, "supported_remote_regions", privateLinkID, err))
 	}
-	return resourceRead(ctx, d, meta)
+	return append(policycheck.WarningsSDKv2(ctx, meta, projectID, policycheck.ActionPrivateNetworkingModify), resourceRead(ctx, d, meta)...)
 }
 
 func resourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
@@ -325,7 +332,9 @@ func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	projectID := ids["project_id"]
 	providerName := ids["provider_name"]
 
+	unlock := concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeNetworking))
 	resp, err := connV2.PrivateEndpointServicesAPI.DeletePrivateEndpointService(ctx, projectID, providerName, privateLinkID).Execute()
+	unlock()
 	if err != nil {
 		if validate.StatusNotFound(resp) {
 			return nil
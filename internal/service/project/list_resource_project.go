@@ -0,0 +1,77 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+var _ list.ListResourceWithConfigure = &projectListRS{}
+
+func ListResource() list.ListResource {
+	return &projectListRS{
+		LSCommon: config.LSCommon{
+			ResourceName: projectResourceName,
+		},
+	}
+}
+
+type projectListRS struct {
+	config.LSCommon
+}
+
+type TFProjectListModel struct {
+	OrgID types.String `tfsdk:"org_id"`
+}
+
+func (l *projectListRS) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the organization whose projects are listed. If not set, all the projects that the API key or service account has access to are listed.",
+			},
+		},
+	}
+}
+
+func (l *projectListRS) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var model TFProjectListModel
+	diags := req.Config.Get(ctx, &model)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	orgID := model.OrgID.ValueString()
+	projects, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.Group], *http.Response, error) {
+		// Use the organization endpoint when org_id is set so only the projects of the organization are paged.
+		if orgID != "" {
+			return l.Client.AtlasV2.OrganizationsAPI.GetOrgGroups(ctx, orgID).PageNum(pageNum).Execute()
+		}
+		return l.Client.AtlasV2.ProjectsAPI.ListGroupsWithParams(ctx, &admin.ListGroupsApiParams{}).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		diags.AddError("error in mongodbatlas_project list resource", fmt.Sprintf("error getting projects information: %s", err.Error()))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	items := make([]config.ListItem, 0, len(projects))
+	for i := range projects {
+		items = append(items, config.ListItem{
+			DisplayName: projects[i].Name,
+			Identity: map[string]string{
+				"id": projects[i].GetId(),
+			},
+		})
+	}
+	l.StreamListItems(ctx, req, stream, Resource().(config.ImplementedResource), items)
+}
@@ -0,0 +1,82 @@
+package project_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/atlas-sdk/v20250312023/mockadmin"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/project"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/unit"
+)
+
+func TestListResource(t *testing.T) {
+	const orgID = "6575af27f93c7a6a4b50b23a"
+	page1 := &admin.PaginatedAtlasGroup{
+		Results:    []admin.Group{{Id: new("id1"), Name: "name1"}, {Id: new("id2"), Name: "name2"}},
+		TotalCount: conversion.IntPtr(3),
+	}
+	page2 := &admin.PaginatedAtlasGroup{
+		Results:    []admin.Group{{Id: new("id3"), Name: "name3"}},
+		TotalCount: conversion.IntPtr(3),
+	}
+	displayNames := map[string]string{"id1": "name1", "id2": "name2", "id3": "name3"}
+	testCases := map[string]struct {
+		client   func(t *testing.T) *admin.APIClient
+		req      unit.ListRequest
+		expected []string
+	}{
+		"all projects in all pages": {
+			client: func(t *testing.T) *admin.APIClient {
+				t.Helper()
+				m := mockadmin.NewProjectsAPI(t)
+				m.EXPECT().ListGroupsWithParams(mock.Anything, mock.Anything).Return(admin.ListGroupsApiRequest{ApiService: m}).Times(2)
+				m.EXPECT().ListGroupsExecute(mock.Anything).Return(page1, nil, nil).Once()
+				m.EXPECT().ListGroupsExecute(mock.Anything).Return(page2, nil, nil).Once()
+				return &admin.APIClient{ProjectsAPI: m}
+			},
+			expected: []string{"id1", "id2", "id3"},
+		},
+		"projects of the organization": {
+			client: func(t *testing.T) *admin.APIClient {
+				t.Helper()
+				m := mockadmin.NewOrganizationsAPI(t)
+				m.EXPECT().GetOrgGroups(mock.Anything, orgID).Return(admin.GetOrgGroupsApiRequest{ApiService: m}).Times(2)
+				m.EXPECT().GetOrgGroupsExecute(mock.Anything).Return(page1, nil, nil).Once()
+				m.EXPECT().GetOrgGroupsExecute(mock.Anything).Return(page2, nil, nil).Once()
+				return &admin.APIClient{OrganizationsAPI: m}
+			},
+			req:      unit.ListRequest{Attributes: map[string]any{"org_id": orgID}},
+			expected: []string{"id1", "id2", "id3"},
+		},
+		"limit": {
+			client: func(t *testing.T) *admin.APIClient {
+				t.Helper()
+				m := mockadmin.NewProjectsAPI(t)
+				m.EXPECT().ListGroupsWithParams(mock.Anything, mock.Anything).Return(admin.ListGroupsApiRequest{ApiService: m}).Times(2)
+				m.EXPECT().ListGroupsExecute(mock.Anything).Return(page1, nil, nil).Once()
+				m.EXPECT().ListGroupsExecute(mock.Anything).Return(page2, nil, nil).Once()
+				return &admin.APIClient{ProjectsAPI: m}
+			},
+			req:      unit.ListRequest{Limit: 2},
+			expected: []string{"id1", "id2"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			results := unit.ListResources(t, project.ListResource(), project.Resource(), tc.client(t), tc.req)
+			require.Len(t, results, len(tc.expected))
+			for i, id := range tc.expected {
+				unit.AssertDiagsOK(t, results[i].Diagnostics)
+				assert.Equal(t, displayNames[id], results[i].DisplayName)
+				assert.Equal(t, map[string]string{"id": id}, unit.ListResultIdentity(t, &results[i]))
+				assert.True(t, results[i].Resource.Raw.IsNull(), "resource is only populated when include_resource is set")
+			}
+		})
+	}
+}
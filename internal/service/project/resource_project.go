@@ -35,6 +35,7 @@ const (
 
 var _ resource.ResourceWithConfigure = &projectRS{}
 var _ resource.ResourceWithImportState = &projectRS{}
+var _ resource.ResourceWithIdentity = &projectRS{}
 
 func Resource() resource.Resource {
 	return &projectRS{
@@ -53,6 +54,10 @@ func (r *projectRS) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *projectRS) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(ResourceSchema(), "id")
+}
+
 func (r *projectRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var projectPlan TFProjectRSModel
 	var teams []TFTeamModel
@@ -0,0 +1,98 @@
+package projectipaccesslist
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	autogenprojectipaccesslist "github.com/mongodb/terraform-provider-mongodbatlas/internal/serviceapi/projectipaccesslist"
+)
+
+var _ list.ListResourceWithConfigure = &projectIPAccessListListRS{}
+
+func ListResource() list.ListResource {
+	return &projectIPAccessListListRS{
+		LSCommon: config.LSCommon{
+			ResourceName: projectIPAccessList,
+		},
+	}
+}
+
+type projectIPAccessListListRS struct {
+	config.LSCommon
+}
+
+func (l *projectIPAccessListListRS) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the project whose access list entries are listed.",
+			},
+		},
+	}
+}
+
+// List reuses the paginated read of the mongodbatlas_project_ip_access_lists data source.
+func (l *projectIPAccessListListRS) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var model autogenprojectipaccesslist.TFPluralDSModel
+	diags := req.Config.GetAttribute(ctx, path.Root("project_id"), &model.ProjectId)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	dsSchema := autogenprojectipaccesslist.PluralDataSourceSchema(ctx)
+	dsState := tfsdk.State{
+		Schema: dsSchema,
+		Raw:    tftypes.NewValue(dsSchema.Type().TerraformType(ctx), nil),
+	}
+	autogen.HandleDataSourceReadList(ctx, autogen.HandleReadReq{
+		RespDiags: &diags,
+		RespState: &dsState,
+		Client:    l.Client,
+		State:     &model,
+		CallParams: &config.APICallParams{
+			VersionHeader: "application/vnd.atlas.2023-01-01+json",
+			RelativePath:  "/api/atlas/v2/groups/{projectId}/accessList",
+			PathParams:    map[string]string{"projectId": model.ProjectId.ValueString()},
+			Method:        "GET",
+		},
+	})
+	var results []autogenprojectipaccesslist.TFPluralDSResultsModel
+	if !diags.HasError() {
+		diags.Append(model.Results.ElementsAs(ctx, &results, false)...)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	items := make([]config.ListItem, 0, len(results))
+	for i := range results {
+		entry := listEntry(&results[i])
+		items = append(items, config.ListItem{
+			DisplayName: entry,
+			Identity: map[string]string{
+				"project_id": model.ProjectId.ValueString(),
+				"entry":      entry,
+			},
+		})
+	}
+	l.StreamListItems(ctx, req, stream, Resource().(config.ImplementedResource), items)
+}
+
+// listEntry returns the same entry as the resource ID, see NewTfProjectIPAccessListModel.
+func listEntry(result *autogenprojectipaccesslist.TFPluralDSResultsModel) string {
+	if result.CidrBlock.ValueString() != "" {
+		return result.CidrBlock.ValueString()
+	}
+	if result.AwsSecurityGroup.ValueString() != "" {
+		return result.AwsSecurityGroup.ValueString()
+	}
+	return result.IpAddress.ValueString()
+}
@@ -0,0 +1,87 @@
+package projectipaccesslist_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/projectipaccesslist"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/unit"
+)
+
+const listProjectID = "6575af27f93c7a6a4b50b239"
+
+// accessListTransport answers the access list pages by page number and the entries by their path.
+type accessListTransport struct {
+	pages   map[string]string
+	entries map[string]string
+}
+
+func (a *accessListTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	listPath := "/api/atlas/v2/groups/" + listProjectID + "/accessList"
+	body, found := "", false
+	if req.URL.Path == listPath {
+		body, found = a.pages[req.URL.Query().Get("pageNum")]
+	} else if entry, ok := strings.CutPrefix(req.URL.EscapedPath(), listPath+"/"); ok {
+		entry, _ = url.PathUnescape(entry)
+		body, found = a.entries[entry]
+	}
+	status := http.StatusOK
+	if !found {
+		status = http.StatusNotFound
+		body = fmt.Sprintf(`{"error": 404, "errorCode": "RESOURCE_NOT_FOUND", "detail": %q}`, req.URL.Path)
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestListResource(t *testing.T) {
+	cidrEntry := fmt.Sprintf(`{"groupId": %q, "cidrBlock": "10.0.0.0/16", "comment": "cidr"}`, listProjectID)
+	sgEntry := fmt.Sprintf(`{"groupId": %q, "awsSecurityGroup": "sg-12345678"}`, listProjectID)
+	ipEntry := fmt.Sprintf(`{"groupId": %q, "ipAddress": "10.1.1.1", "cidrBlock": "10.1.1.1/32"}`, listProjectID)
+	transport := &accessListTransport{
+		pages: map[string]string{
+			"1": fmt.Sprintf(`{"results": [%s, %s], "totalCount": 3}`, cidrEntry, sgEntry),
+			"2": fmt.Sprintf(`{"results": [%s], "totalCount": 3}`, ipEntry),
+		},
+		entries: map[string]string{"10.0.0.0/16": cidrEntry},
+	}
+	atlasV2, err := admin.NewClient(admin.UseHTTPClient(&http.Client{Transport: transport}), admin.UseBaseURL("https://atlas.test"))
+	require.NoError(t, err)
+	attributes := map[string]any{"project_id": listProjectID}
+
+	results := unit.ListResources(t, projectipaccesslist.ListResource(), projectipaccesslist.Resource(), atlasV2, unit.ListRequest{Attributes: attributes})
+	entries := []string{"10.0.0.0/16", "sg-12345678", "10.1.1.1/32"}
+	require.Len(t, results, len(entries), "entries in all pages are listed")
+	for i, entry := range entries {
+		unit.AssertDiagsOK(t, results[i].Diagnostics)
+		assert.Equal(t, entry, results[i].DisplayName)
+		assert.Equal(t, map[string]string{"project_id": listProjectID, "entry": entry}, unit.ListResultIdentity(t, &results[i]))
+		assert.True(t, results[i].Resource.Raw.IsNull())
+	}
+
+	results = unit.ListResources(t, projectipaccesslist.ListResource(), projectipaccesslist.Resource(), atlasV2, unit.ListRequest{Attributes: attributes, IncludeResource: true, Limit: 1})
+	require.Len(t, results, 1)
+	unit.AssertDiagsOK(t, results[0].Diagnostics)
+	var id, cidrBlock, comment types.String
+	unit.AssertDiagsOK(t, results[0].Resource.GetAttribute(t.Context(), path.Root("id"), &id))
+	unit.AssertDiagsOK(t, results[0].Resource.GetAttribute(t.Context(), path.Root("cidr_block"), &cidrBlock))
+	unit.AssertDiagsOK(t, results[0].Resource.GetAttribute(t.Context(), path.Root("comment"), &comment))
+	assert.Equal(t, conversion.EncodeStateID(map[string]string{"project_id": listProjectID, "entry": "10.0.0.0/16"}), id.ValueString())
+	assert.Equal(t, "10.0.0.0/16", cidrBlock.ValueString())
+	assert.Equal(t, "cidr", comment.ValueString(), "resource is populated by Read")
+}
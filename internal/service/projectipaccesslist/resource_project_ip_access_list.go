@@ -9,11 +9,16 @@ import (
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/concurrency"
 	"go.mongodb.org/atlas-sdk/v20250312023/admin"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/policycheck"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 )
@@ -31,9 +36,11 @@ const (
 
 // Each access list entry is its own resource, which leads to concurrent calls within a single execution unless explicitly serialized by the user.
 // From API docs: "This endpoint doesn't support concurrent POST requests. You must submit multiple POST requests synchronously."
-// Locking both POSTs and DELETEs at the project level to avoid race conditions within a single execution.
+// Locking both POSTs and DELETEs at the project level with the provider-wide operation lock to avoid race conditions within a single execution.
 // Still, we verify that the entry was added/removed to/from the access list and retry otherwise in case of an external action.
-var accessListEntryMutex = concurrency.NewMutexKV()
+func lockProject(projectID string) (unlock func()) {
+	return concurrency.LockOperation(concurrency.ProjectOperationKey(projectID, concurrency.ScopeAccessList))
+}
 
 type projectIPAccessListRS struct {
 	config.RSCommon
@@ -48,13 +55,53 @@ func Resource() resource.Resource {
 }
 
 var _ resource.ResourceWithConfigure = &projectIPAccessListRS{}
+var _ resource.ResourceWithModifyPlan = &projectIPAccessListRS{}
 var _ resource.ResourceWithImportState = &projectIPAccessListRS{}
+var _ resource.ResourceWithIdentity = &projectIPAccessListRS{}
+var _ config.ResourceWithIdentityFromState = &projectIPAccessListRS{}
+var _ config.ResourceWithImportStateFromIdentity = &projectIPAccessListRS{}
 
 func (r *projectIPAccessListRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = ResourceSchema(ctx)
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *projectIPAccessListRS) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(ResourceSchema(ctx), "project_id", "entry")
+	resp.IdentitySchema.Attributes["entry"] = identityschema.StringAttribute{
+		RequiredForImport: true,
+		Description:       "Access list entry, it can be a CIDR block, an IP address or an AWS security group ID.",
+	}
+}
+
+// ModifyPlan warns about the resource policies of the organization that may restrict the new entry, Atlas enforces them when it's added.
+func (r *projectIPAccessListRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.Client == nil || !r.Client.CheckResourcePolicies || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state TfProjectIPAccessListModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || plan.ProjectID.IsUnknown() {
+		return
+	}
+	for _, attr := range []struct {
+		plan, state types.String
+		name        string
+	}{
+		{name: "cidr_block", plan: plan.CIDRBlock, state: state.CIDRBlock},
+		{name: "ip_address", plan: plan.IPAddress, state: state.IPAddress},
+	} {
+		if attr.plan.IsUnknown() || attr.plan.ValueString() == "" || attr.plan.Equal(attr.state) {
+			continue
+		}
+		resp.Diagnostics.Append(policycheck.Check(ctx, r.Client.AtlasV2, plan.ProjectID.ValueString(), policycheck.ActionIPAccessListModify, path.Root(attr.name))...)
+		return
+	}
+}
+
 func (r *projectIPAccessListRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var projectIPAccessListModel *TfProjectIPAccessListModel
 
@@ -166,10 +213,10 @@ func (r *projectIPAccessListRS) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		accessListEntryMutex.Lock(projectID)
+		unlock := lockProject(projectID)
 		httpResponse, err := connV2.ProjectIPAccessListAPI.DeleteAccessListEntry(ctx, projectID, entry).Execute()
 		// Unlock immediately to allow parallel reads (intentionally not deferring).
-		accessListEntryMutex.Unlock(projectID)
+		unlock()
 		if err != nil {
 			if validate.StatusInternalServerError(httpResponse) {
 				return retry.RetryableError(err)
@@ -221,16 +268,35 @@ func (r *projectIPAccessListRS) ImportState(ctx context.Context, req resource.Im
 	}))...)
 }
 
+// IdentityFromState is needed because the entry in the resource ID can be any of cidr_block, ip_address or aws_security_group.
+func (r *projectIPAccessListRS) IdentityFromState(ctx context.Context, state *tfsdk.State) (map[string]string, diag.Diagnostics) {
+	var id types.String
+	diags := state.GetAttribute(ctx, path.Root("id"), &id)
+	decodedIDMap := conversion.DecodeStateID(id.ValueString())
+	if len(decodedIDMap) != 2 {
+		diags.AddError("error getting identity", "the resource ID is not correct")
+		return nil, diags
+	}
+	return decodedIDMap, diags
+}
+
+func (r *projectIPAccessListRS) ImportStateFromIdentity(ctx context.Context, identity map[string]string, state *tfsdk.State) diag.Diagnostics {
+	return state.SetAttribute(ctx, path.Root("id"), conversion.EncodeStateID(map[string]string{
+		"entry":      identity["entry"],
+		"project_id": identity["project_id"],
+	}))
+}
+
 func createEntry(ctx context.Context, connV2 *admin.APIClient, projectIPAccessListModel *TfProjectIPAccessListModel, timeout time.Duration, errorMsg string) (*admin.NetworkPermissionEntry, error) {
 	projectID := projectIPAccessListModel.ProjectID.ValueString()
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"created", "failed"},
 		Refresh: func() (any, string, error) {
-			accessListEntryMutex.Lock(projectID)
+			unlock := lockProject(projectID)
 			_, httpResponse, err := connV2.ProjectIPAccessListAPI.CreateAccessListEntry(ctx, projectID, NewMongoDBProjectIPAccessList(projectIPAccessListModel)).Execute()
 			// Unlock immediately to allow parallel reads (intentionally not deferring).
-			accessListEntryMutex.Unlock(projectID)
+			unlock()
 			if err != nil {
 				if validate.StatusInternalServerError(httpResponse) {
 					return nil, "pending", nil
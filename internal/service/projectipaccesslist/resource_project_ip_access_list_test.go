@@ -53,6 +53,28 @@ func TestAccProjectIPAccessList_settingIPAddress(t *testing.T) {
 	})
 }
 
+func TestAccProjectIPAccessList_importWithIdentity(t *testing.T) {
+	var (
+		projectID = acc.ProjectIDExecution(t)
+		cidrBlock = acc.RandomIP() + "/32"
+		comment   = fmt.Sprintf("TestAcc for import with identity (%s)", cidrBlock)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		TerraformVersionChecks:   acc.IdentityVersionChecks(),
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: configWithCIDRBlock(projectID, cidrBlock, comment, false),
+				Check:  resource.ComposeAggregateTestCheckFunc(commonChecks("", cidrBlock, "", comment, false)...),
+			},
+			acc.ImportStepWithIdentity(resourceName),
+		},
+	})
+}
+
 func TestAccProjectIPAccessList_settingCIDRBlock(t *testing.T) {
 	var (
 		projectID        = acc.ProjectIDExecution(t)
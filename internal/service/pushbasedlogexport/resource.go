@@ -28,6 +28,7 @@ const (
 
 var _ resource.ResourceWithConfigure = &pushBasedLogExportRS{}
 var _ resource.ResourceWithImportState = &pushBasedLogExportRS{}
+var _ resource.ResourceWithIdentity = &pushBasedLogExportRS{}
 
 func Resource() resource.Resource {
 	return &pushBasedLogExportRS{
@@ -46,6 +47,10 @@ func (r *pushBasedLogExportRS) Schema(ctx context.Context, req resource.SchemaRe
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *pushBasedLogExportRS) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(ResourceSchema(ctx), "project_id")
+}
+
 func (r *pushBasedLogExportRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var tfPlan TFPushBasedLogExportRSModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &tfPlan)...)
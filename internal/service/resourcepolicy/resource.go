@@ -19,6 +19,7 @@ import (
 
 var _ resource.ResourceWithConfigure = &resourcePolicyRS{}
 var _ resource.ResourceWithImportState = &resourcePolicyRS{}
+var _ resource.ResourceWithIdentity = &resourcePolicyRS{}
 var _ resource.ResourceWithModifyPlan = &resourcePolicyRS{}
 
 const (
@@ -70,6 +71,10 @@ func (r *resourcePolicyRS) Schema(ctx context.Context, req resource.SchemaReques
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *resourcePolicyRS) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(ResourceSchema(), "org_id", "id")
+}
+
 func (r *resourcePolicyRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan TFModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
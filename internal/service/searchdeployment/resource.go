@@ -19,6 +19,7 @@ import (
 
 var _ resource.ResourceWithConfigure = &rs{}
 var _ resource.ResourceWithImportState = &rs{}
+var _ resource.ResourceWithIdentity = &rs{}
 
 const resourceName = "search_deployment"
 
@@ -39,6 +40,10 @@ func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resou
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *rs) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(ResourceSchema(ctx), "project_id", "cluster_name")
+}
+
 const defaultSearchNodeTimeout time.Duration = 3 * time.Hour
 const minTimeoutCreateUpdate time.Duration = 1 * time.Minute
 const minTimeoutDelete time.Duration = 30 * time.Second
@@ -184,7 +184,7 @@ func dataSourceMongoDBAtlasSearchIndexRead(ctx context.Context, d *schema.Resour
 	}
 
 	if searchIndex.LatestDefinition.Mappings != nil {
-		if diags := setMappingsAttributesFromDefinition(d, searchIndex.LatestDefinition.Mappings); diags != nil {
+		if diags := setMappingsAttributesFromDefinition(d, searchIndex.LatestDefinition.Mappings, false); diags != nil {
 			return diags
 		}
 	}
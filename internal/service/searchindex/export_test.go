@@ -0,0 +1,17 @@
+package searchindex
+
+// Test helpers exported only for package searchindex_test (see validate_search_index_test.go).
+
+type IndexDefinitionForTest = indexDefinition
+
+func ValidateDefinitionForTest(def *IndexDefinitionForTest) (warnings []string, err error) {
+	return validateDefinition(def)
+}
+
+func MappingsFieldsFromStructuredForTest(elements []any) (map[string]any, error) {
+	return mappingsFieldsFromStructured(elements)
+}
+
+func FlattenMappingsFieldsStructuredForTest(fields map[string]any) ([]map[string]any, error) {
+	return flattenSearchIndexMappingsFieldsStructured(fields)
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -151,10 +153,6 @@ func UnmarshalStoredSource(str string) (any, diag.Diagnostics) {
 	}
 }
 
-func diffSuppressJSON(k, old, newStr string, d *schema.ResourceData) bool {
-	return schemafunc.EqualJSON(old, newStr, "vector search index")
-}
-
 // searchIndexPendingStates is used for all operations. Over-inclusion is safe; only reaching a target state matters (e.g., an index can be IN_PROGRESS when delete is called).
 var searchIndexPendingStates = []string{"PENDING", "BUILDING", "IN_PROGRESS", "MIGRATING", "DELETING"}
 
@@ -171,21 +169,6 @@ func resourceSearchIndexRefreshFunc(ctx context.Context, clusterName, projectID,
 	}
 }
 
-func canonicalizeJSONString(s string) string {
-	if s == "" {
-		return ""
-	}
-	var v any
-	if err := json.Unmarshal([]byte(s), &v); err != nil {
-		return s
-	}
-	by, err := json.Marshal(v)
-	if err != nil {
-		return s
-	}
-	return string(by)
-}
-
 func hashTypeSetElement(v any) int {
 	m := v.(map[string]any)
 	name := ""
@@ -193,6 +176,138 @@ func hashTypeSetElement(v any) int {
 		name = nv
 	}
 	typesStr, _ := m["types"].(string)
-	canon := canonicalizeJSONString(typesStr)
+	canon := schemafunc.CanonicalJSON(typesStr)
 	return schema.HashString(name + "|" + canon)
 }
+
+// structuredFieldKeys are the keys of a field definition that have their own attribute in mappings_fields_structured,
+// the rest of the keys are set in options.
+var structuredFieldKeys = map[string]string{
+	"type":           "type",
+	"analyzer":       "analyzer",
+	"searchAnalyzer": "search_analyzer",
+	"fields":         "fields",
+}
+
+// mappingsFieldsFromStructured returns the mappings fields of the mappings_fields_structured elements.
+// A field with several elements is indexed as several types, e.g. string and autocomplete.
+func mappingsFieldsFromStructured(elements []any) (map[string]any, error) {
+	fields := map[string]any{}
+	for _, raw := range elements {
+		item := raw.(map[string]any)
+		name := item["name"].(string)
+		def := map[string]any{}
+		if s, _ := item["options"].(string); s != "" {
+			if err := json.Unmarshal([]byte(s), &def); err != nil {
+				return nil, fmt.Errorf("cannot unmarshal search index attribute `mappings_fields_structured.options` of field %s because it has an incorrect format", name)
+			}
+			if def == nil {
+				def = map[string]any{} // options is null
+			}
+			for key, attr := range structuredFieldKeys {
+				if _, ok := def[key]; ok {
+					return nil, fmt.Errorf("search index attribute `mappings_fields_structured.options` of field %s can't contain %s, use %s instead", name, key, attr)
+				}
+			}
+		}
+		def["type"] = item["type"]
+		if s, _ := item["analyzer"].(string); s != "" {
+			def["analyzer"] = s
+		}
+		if s, _ := item["search_analyzer"].(string); s != "" {
+			def["searchAnalyzer"] = s
+		}
+		if s, _ := item["fields"].(string); s != "" {
+			nested := map[string]any{}
+			if err := json.Unmarshal([]byte(s), &nested); err != nil {
+				return nil, fmt.Errorf("cannot unmarshal search index attribute `mappings_fields_structured.fields` of field %s because it has an incorrect format", name)
+			}
+			def["fields"] = nested
+		}
+		switch existing := fields[name].(type) {
+		case nil:
+			fields[name] = def
+		case map[string]any:
+			fields[name] = []any{existing, def}
+		case []any:
+			fields[name] = append(existing, def)
+		}
+	}
+	return fields, nil
+}
+
+func expandSearchIndexMappingsFieldsStructured(d *schema.ResourceData) (map[string]any, diag.Diagnostics) {
+	fields, err := mappingsFieldsFromStructured(d.Get("mappings_fields_structured").(*schema.Set).List())
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return fields, nil
+}
+
+// expandSearchIndexMappingsFields returns the mappings fields from mappings_fields_structured if it's set, or from mappings_fields otherwise.
+func expandSearchIndexMappingsFields(d *schema.ResourceData) (map[string]any, diag.Diagnostics) {
+	if _, ok := d.GetOk("mappings_fields_structured"); ok {
+		return expandSearchIndexMappingsFieldsStructured(d)
+	}
+	return unmarshalSearchIndexMappingFields(d.Get("mappings_fields").(string))
+}
+
+func flattenSearchIndexMappingsFieldsStructured(fields map[string]any) ([]map[string]any, error) {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var elements []map[string]any
+	for _, name := range names {
+		defs, ok := fields[name].([]any)
+		if !ok {
+			defs = []any{fields[name]}
+		}
+		for _, raw := range defs {
+			def, ok := raw.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("unexpected definition of search index field %s: %v", name, raw)
+			}
+			element := map[string]any{"name": name, "options": "", "fields": ""}
+			options := map[string]any{}
+			for key, value := range def {
+				attr, ok := structuredFieldKeys[key]
+				if !ok {
+					options[key] = value
+					continue
+				}
+				if key == "fields" {
+					nested, err := marshalSearchIndex(value)
+					if err != nil {
+						return nil, err
+					}
+					element[attr] = nested
+				} else {
+					element[attr] = value
+				}
+			}
+			if len(options) > 0 {
+				j, err := marshalSearchIndex(options)
+				if err != nil {
+					return nil, err
+				}
+				element["options"] = j
+			}
+			elements = append(elements, element)
+		}
+	}
+	return elements, nil
+}
+
+func hashMappingsFieldsStructuredElement(v any) int {
+	m := v.(map[string]any)
+	var key string
+	for _, attr := range []string{"name", "type", "analyzer", "search_analyzer"} {
+		s, _ := m[attr].(string)
+		key += s + "|"
+	}
+	options, _ := m["options"].(string)
+	nested, _ := m["fields"].(string)
+	return schema.HashString(key + schemafunc.CanonicalJSON(options) + "|" + schemafunc.CanonicalJSON(nested))
+}
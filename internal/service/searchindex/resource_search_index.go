@@ -2,6 +2,7 @@ package searchindex
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/retrystrategy"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/schemafunc"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 	"go.mongodb.org/atlas-sdk/v20250312023/admin"
@@ -30,6 +32,7 @@ func Resource() *schema.Resource {
 		ReadContext:          resourceRead,
 		UpdateWithoutTimeout: resourceUpdate,
 		DeleteContext:        resourceDelete,
+		CustomizeDiff:        resourceCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceImportState,
 		},
@@ -65,7 +68,7 @@ func returnSearchIndexSchema() map[string]*schema.Schema {
 		"analyzers": {
 			Type:             schema.TypeString,
 			Optional:         true,
-			DiffSuppressFunc: diffSuppressJSON,
+			DiffSuppressFunc: schemafunc.DiffSuppressJSON,
 		},
 		"collection_name": {
 			Type:     schema.TypeString,
@@ -91,13 +94,50 @@ func returnSearchIndexSchema() map[string]*schema.Schema {
 		"mappings_dynamic_config": {
 			Type:             schema.TypeString,
 			Optional:         true,
-			DiffSuppressFunc: diffSuppressJSON,
+			DiffSuppressFunc: schemafunc.DiffSuppressJSON,
 			ConflictsWith:    []string{"mappings_dynamic"},
 		},
 		"mappings_fields": {
 			Type:             schema.TypeString,
 			Optional:         true,
-			DiffSuppressFunc: diffSuppressJSON,
+			DiffSuppressFunc: schemafunc.DiffSuppressJSON,
+			ConflictsWith:    []string{"mappings_fields_structured"},
+		},
+		"mappings_fields_structured": {
+			Type:          schema.TypeSet,
+			Optional:      true,
+			Set:           hashMappingsFieldsStructuredElement,
+			ConflictsWith: []string{"mappings_fields"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"type": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"analyzer": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"search_analyzer": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"options": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: schemafunc.DiffSuppressJSON,
+					},
+					"fields": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: schemafunc.DiffSuppressJSON,
+					},
+				},
+			},
 		},
 		"synonyms": {
 			Type:     schema.TypeSet,
@@ -134,12 +174,12 @@ func returnSearchIndexSchema() map[string]*schema.Schema {
 		"fields": {
 			Type:             schema.TypeString,
 			Optional:         true,
-			DiffSuppressFunc: diffSuppressJSON,
+			DiffSuppressFunc: schemafunc.DiffSuppressJSON,
 		},
 		"stored_source": {
 			Type:             schema.TypeString,
 			Optional:         true,
-			DiffSuppressFunc: diffSuppressJSON,
+			DiffSuppressFunc: schemafunc.DiffSuppressJSON,
 		},
 		"type_sets": {
 			Type:     schema.TypeSet,
@@ -154,7 +194,7 @@ func returnSearchIndexSchema() map[string]*schema.Schema {
 					"types": {
 						Type:             schema.TypeString,
 						Optional:         true,
-						DiffSuppressFunc: diffSuppressJSON,
+						DiffSuppressFunc: schemafunc.DiffSuppressJSON,
 					},
 				},
 			},
@@ -166,7 +206,111 @@ func returnSearchIndexSchema() map[string]*schema.Schema {
 	}
 }
 
-func setMappingsAttributesFromDefinition(d *schema.ResourceData, mappings *admin.SearchMappings) diag.Diagnostics {
+// definitionAttributes are the attributes validated by resourceCustomizeDiff.
+var definitionAttributes = []string{
+	"type", "analyzer", "analyzers", "search_analyzer", "mappings_fields", "mappings_fields_structured", "synonyms", "fields", "stored_source",
+}
+
+// resourceCustomizeDiff validates the index definition during plan when it's created or changed, so errors like references to
+// undefined analyzers fail the plan instead of the index build. The definition is not validated if any of its attributes is unknown.
+// Warnings can't be returned from CustomizeDiff, they are returned by definitionWarnings in Create and Update.
+func resourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() != "" && !d.HasChanges(definitionAttributes...) {
+		return nil
+	}
+	for _, attr := range definitionAttributes {
+		if !d.NewValueKnown(attr) {
+			return nil
+		}
+	}
+	def, err := newIndexDefinition(d)
+	if def == nil || err != nil {
+		return err
+	}
+	_, err = validateDefinition(def)
+	return err
+}
+
+// definitionWarnings returns the warnings of the index definition validation, e.g. field types unknown to the provider.
+func definitionWarnings(d *schema.ResourceData) diag.Diagnostics {
+	def, err := newIndexDefinition(d)
+	if def == nil || err != nil {
+		return nil // Errors are returned by resourceCustomizeDiff.
+	}
+	warnings, _ := validateDefinition(def)
+	var diags diag.Diagnostics
+	for _, warning := range warnings {
+		diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: "Search index definition not fully validated", Detail: warning})
+	}
+	return diags
+}
+
+// resourceGetter is implemented by schema.ResourceData and schema.ResourceDiff.
+type resourceGetter interface {
+	Get(key string) any
+	GetOk(key string) (any, bool)
+}
+
+// newIndexDefinition returns the index definition to validate, or nil if mappings_fields_structured has unknown values.
+func newIndexDefinition(d resourceGetter) (*indexDefinition, error) {
+	def := &indexDefinition{
+		Type:           d.Get("type").(string),
+		Analyzer:       d.Get("analyzer").(string),
+		SearchAnalyzer: d.Get("search_analyzer").(string),
+	}
+	var err error
+	if def.Type == vectorSearch {
+		if def.VectorFields, err = unmarshalJSONForValidation[[]map[string]any](d.Get("fields").(string), "fields"); err != nil {
+			return nil, err
+		}
+	} else {
+		analyzers, err := unmarshalJSONForValidation[[]struct {
+			Name string `json:"name"`
+		}](d.Get("analyzers").(string), "analyzers")
+		if err != nil {
+			return nil, err
+		}
+		for _, analyzer := range analyzers {
+			def.CustomAnalyzers = append(def.CustomAnalyzers, analyzer.Name)
+		}
+		for _, raw := range d.Get("synonyms").(*schema.Set).List() {
+			def.SynonymAnalyzers = append(def.SynonymAnalyzers, raw.(map[string]any)["analyzer"].(string))
+		}
+		if v, ok := d.GetOk("mappings_fields_structured"); ok {
+			elements := v.(*schema.Set).List()
+			for _, raw := range elements {
+				if item := raw.(map[string]any); item["name"] == "" || item["type"] == "" {
+					return nil, nil // Unknown values in the set are empty.
+				}
+			}
+			if def.MappingsFields, err = mappingsFieldsFromStructured(elements); err != nil {
+				return nil, err
+			}
+		} else if def.MappingsFields, err = unmarshalJSONForValidation[map[string]any](d.Get("mappings_fields").(string), "mappings_fields"); err != nil {
+			return nil, err
+		}
+	}
+	if storedSource := d.Get("stored_source").(string); storedSource != "true" && storedSource != "false" {
+		if def.StoredSource, err = unmarshalJSONForValidation[any](storedSource, "stored_source"); err != nil {
+			return nil, err
+		}
+	}
+	return def, nil
+}
+
+func unmarshalJSONForValidation[T any](str, attr string) (T, error) {
+	var value T
+	if str == "" {
+		return value, nil
+	}
+	if err := json.Unmarshal([]byte(str), &value); err != nil {
+		return value, fmt.Errorf("cannot unmarshal search index attribute `%s` because it has an incorrect format", attr)
+	}
+	return value, nil
+}
+
+// setMappingsAttributesFromDefinition sets the mappings attributes, with the fields in mappings_fields_structured if structuredFields is true.
+func setMappingsAttributesFromDefinition(d *schema.ResourceData, mappings *admin.SearchMappings, structuredFields bool) diag.Diagnostics {
 	if mappings == nil {
 		return nil
 	}
@@ -189,6 +333,16 @@ func setMappingsAttributesFromDefinition(d *schema.ResourceData, mappings *admin
 	}
 
 	if fields := mappings.Fields; fields != nil && len(*fields) > 0 {
+		if structuredFields {
+			elements, err := flattenSearchIndexMappingsFieldsStructured(*fields)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set("mappings_fields_structured", elements); err != nil {
+				return diag.Errorf("error setting `mappings_fields_structured` for search index (%s): %s", d.Id(), err)
+			}
+			return nil
+		}
 		searchIndexMappingFields, err := marshalSearchIndex(*fields)
 		if err != nil {
 			return diag.FromErr(err)
@@ -334,8 +488,8 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		searchIndex.Definition.Mappings.Dynamic = &dynamic
 	}
 
-	if d.HasChange("mappings_fields") {
-		mappingsFields, err := unmarshalSearchIndexMappingFields(d.Get("mappings_fields").(string))
+	if d.HasChanges("mappings_fields", "mappings_fields_structured") {
+		mappingsFields, err := expandSearchIndexMappingsFields(d)
 		if err != nil {
 			return err
 		}
@@ -404,7 +558,11 @@ func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		}
 	}
 
-	return resourceRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	if d.HasChanges(definitionAttributes...) {
+		diags = definitionWarnings(d)
+	}
+	return append(diags, resourceRead(ctx, d, meta)...)
 }
 
 func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -467,7 +625,8 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	}
 
 	if searchIndex.LatestDefinition.Mappings != nil {
-		if diags := setMappingsAttributesFromDefinition(d, searchIndex.LatestDefinition.Mappings); diags != nil {
+		_, structuredFields := d.GetOk("mappings_fields_structured")
+		if diags := setMappingsAttributesFromDefinition(d, searchIndex.LatestDefinition.Mappings, structuredFields); diags != nil {
 			return diags
 		}
 	}
@@ -545,7 +704,7 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 			return err
 		}
 		searchIndexRequest.Definition.Analyzers = &analyzers
-		mappingsFields, err := unmarshalSearchIndexMappingFields(d.Get("mappings_fields").(string))
+		mappingsFields, err := expandSearchIndexMappingsFields(d)
 		if err != nil {
 			return err
 		}
@@ -619,5 +778,5 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		"index_id":     indexID,
 	}))
 
-	return resourceRead(ctx, d, meta)
+	return append(definitionWarnings(d), resourceRead(ctx, d, meta)...)
 }
@@ -189,6 +189,36 @@ func TestAccSearchIndex_updatedToEmptyMappingsFields(t *testing.T) {
 	})
 }
 
+func TestAccSearchIndex_withMappingsFieldsStructured(t *testing.T) {
+	var (
+		projectID, clusterName = acc.ClusterNameExecution(t, true)
+		indexName              = acc.RandomName()
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             acc.CheckDestroySearchIndex,
+		Steps: []resource.TestStep{
+			{
+				Config:      configAdditional(projectID, indexName, clusterName, mappingsFieldsStructuredUndefinedAnalyzerTF),
+				ExpectError: regexp.MustCompile(`analyzer "undefined_analyzer" is not defined in analyzers`),
+			},
+			{
+				Config: configAdditional(projectID, indexName, clusterName, mappingsFieldsStructuredTF),
+				Check: checkAggr(projectID, clusterName, indexName, "", "true",
+					resource.TestCheckResourceAttr(resourceName, "mappings_fields", ""),
+					resource.TestCheckResourceAttr(resourceName, "mappings_fields_structured.#", "3"),
+					resource.TestCheckResourceAttrWith(datasourceName, "mappings_fields", acc.JSONEquals(mappingsFieldsJSON)),
+				),
+			},
+			{
+				Config:   configAdditional(projectID, indexName, clusterName, mappingsFieldsStructuredTF),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccSearchIndex_withVector(t *testing.T) {
 	resource.ParallelTest(t, *basicVectorTestCase(t))
 }
@@ -777,6 +807,36 @@ const (
 	incorrectFormatAnalyzersTF = "\nanalyzers = <<-EOF\n" + incorrectFormatAnalyzersJSON + "\nEOF\n"
 	mappingsFieldsTF           = "\nmappings_fields = <<-EOF\n" + mappingsFieldsJSON + "\nEOF\n"
 
+	// Same mappings as mappingsFieldsJSON.
+	mappingsFieldsStructuredTF = `
+		mappings_fields_structured {
+			name   = "address"
+			type   = "document"
+			fields = jsonencode({
+				city  = { type = "string", analyzer = "lucene.simple", ignoreAbove = 255 }
+				state = { type = "string", analyzer = "lucene.english" }
+			})
+		}
+		mappings_fields_structured {
+			name     = "company"
+			type     = "string"
+			analyzer = "lucene.whitespace"
+			options  = jsonencode({ multi = { mySecondaryAnalyzer = { type = "string", analyzer = "lucene.french" } } })
+		}
+		mappings_fields_structured {
+			name     = "employees"
+			type     = "string"
+			analyzer = "lucene.standard"
+		}
+	`
+	mappingsFieldsStructuredUndefinedAnalyzerTF = `
+		mappings_fields_structured {
+			name     = "employees"
+			type     = "string"
+			analyzer = "undefined_analyzer"
+		}
+	`
+
 	analyzersJSON = `
 		[
 			{
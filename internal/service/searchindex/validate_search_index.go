@@ -0,0 +1,224 @@
+package searchindex
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const maxVectorDimensions = 8192
+
+var (
+	searchFieldTypes = []string{
+		"autocomplete", "boolean", "date", "dateFacet", "document", "embeddedDocuments", "geo", "knnVector",
+		"number", "numberFacet", "objectId", "string", "stringFacet", "token", "uuid",
+	}
+	vectorSearchFieldTypes = []string{"vector", "filter", "autoEmbed"}
+	vectorSimilarities     = []string{"euclidean", "cosine", "dotProduct"}
+	vectorQuantizations    = []string{"none", "scalar", "binary"}
+	builtInAnalyzers       = []string{
+		"lucene.standard", "lucene.simple", "lucene.whitespace", "lucene.keyword",
+		"lucene.arabic", "lucene.armenian", "lucene.basque", "lucene.bengali", "lucene.brazilian", "lucene.bulgarian",
+		"lucene.catalan", "lucene.chinese", "lucene.cjk", "lucene.czech", "lucene.danish", "lucene.dutch", "lucene.english",
+		"lucene.finnish", "lucene.french", "lucene.galician", "lucene.german", "lucene.greek", "lucene.hindi",
+		"lucene.hungarian", "lucene.indonesian", "lucene.irish", "lucene.italian", "lucene.japanese", "lucene.korean",
+		"lucene.kuromoji", "lucene.latvian", "lucene.lithuanian", "lucene.morfologik", "lucene.nori", "lucene.norwegian",
+		"lucene.persian", "lucene.polish", "lucene.portuguese", "lucene.romanian", "lucene.russian", "lucene.smartcn",
+		"lucene.sorani", "lucene.spanish", "lucene.swedish", "lucene.thai", "lucene.turkish", "lucene.ukrainian",
+	}
+)
+
+// indexDefinition is the part of a search index definition that is validated before it's sent to Atlas.
+type indexDefinition struct {
+	MappingsFields   map[string]any
+	StoredSource     any
+	Analyzer         string
+	SearchAnalyzer   string
+	Type             string
+	VectorFields     []map[string]any
+	SynonymAnalyzers []string
+	CustomAnalyzers  []string // Names of the analyzers defined in the analyzers attribute.
+}
+
+type definitionValidator struct {
+	def      *indexDefinition
+	errs     []error
+	warnings []string
+}
+
+// validateDefinition returns the errors that Atlas would only report when building the index: references to analyzers
+// that don't exist, invalid vectorSearch fields and invalid stored_source.
+// Values that are only unknown to the provider, e.g. field types, built-in analyzers or quantizations added to Atlas later,
+// are returned as warnings instead.
+func validateDefinition(def *indexDefinition) (warnings []string, err error) {
+	v := &definitionValidator{def: def}
+	if def.Type == vectorSearch {
+		v.validateVectorFields()
+	} else {
+		v.validateAnalyzer("analyzer", def.Analyzer)
+		v.validateAnalyzer("search_analyzer", def.SearchAnalyzer)
+		for _, analyzer := range def.SynonymAnalyzers {
+			v.validateAnalyzer("synonyms.analyzer", analyzer)
+		}
+		v.validateFields("mappings_fields", def.MappingsFields)
+	}
+	v.validateStoredSource()
+	return v.warnings, errors.Join(v.errs...)
+}
+
+func (v *definitionValidator) errorf(format string, args ...any) {
+	v.errs = append(v.errs, fmt.Errorf(format, args...))
+}
+
+func (v *definitionValidator) warnf(format string, args ...any) {
+	v.warnings = append(v.warnings, fmt.Sprintf(format, args...))
+}
+
+func (v *definitionValidator) validateAnalyzer(attr, name string) {
+	if name == "" || slices.Contains(builtInAnalyzers, name) || slices.Contains(v.def.CustomAnalyzers, name) {
+		return
+	}
+	if strings.HasPrefix(name, "lucene.") {
+		v.warnf("%s: %q is not a known built-in analyzer, known built-in analyzers are %s and language analyzers like lucene.english", attr, name, strings.Join(builtInAnalyzers[:4], ", "))
+		return
+	}
+	v.errorf("%s: analyzer %q is not defined in analyzers", attr, name)
+}
+
+// validateFields validates the mappings fields, where each field is an object or an array of objects if it's indexed as several types.
+func (v *definitionValidator) validateFields(attr string, fields map[string]any) {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		fieldAttr := attr + "." + name
+		switch def := fields[name].(type) {
+		case map[string]any:
+			v.validateField(fieldAttr, def)
+		case []any:
+			for i, item := range def {
+				if itemDef, ok := item.(map[string]any); ok {
+					v.validateField(fmt.Sprintf("%s[%d]", fieldAttr, i), itemDef)
+				} else {
+					v.errorf("%s[%d]: field definition must be an object", fieldAttr, i)
+				}
+			}
+		default:
+			v.errorf("%s: field definition must be an object or an array of objects", fieldAttr)
+		}
+	}
+}
+
+func (v *definitionValidator) validateField(attr string, def map[string]any) {
+	fieldType, _ := def["type"].(string)
+	if fieldType == "" {
+		v.errorf("%s: type is required", attr)
+		return
+	}
+	knownType := slices.Contains(searchFieldTypes, fieldType)
+	if !knownType {
+		v.warnf("%s: unknown field type %q, known types are %s", attr, fieldType, strings.Join(searchFieldTypes, ", "))
+	}
+	for _, key := range []string{"analyzer", "searchAnalyzer"} {
+		if value, ok := def[key]; ok {
+			name, _ := value.(string)
+			v.validateAnalyzer(attr+"."+key, name)
+		}
+	}
+	if multi, ok := def["multi"].(map[string]any); ok {
+		for name, multiDef := range multi {
+			if multiDefMap, ok := multiDef.(map[string]any); ok {
+				v.validateField(attr+".multi."+name, multiDefMap)
+			}
+		}
+	}
+	if similarity, ok := def["similarity"]; ok && fieldType == "knnVector" {
+		v.validateOneOf(attr+".similarity", similarity, vectorSimilarities)
+	}
+	nested, ok := def["fields"]
+	if !ok {
+		return
+	}
+	if knownType && fieldType != "document" && fieldType != "embeddedDocuments" {
+		v.errorf("%s: fields is only supported in document and embeddedDocuments types", attr)
+		return
+	}
+	if nestedFields, ok := nested.(map[string]any); ok {
+		v.validateFields(attr+".fields", nestedFields)
+	} else {
+		v.errorf("%s.fields: must be an object", attr)
+	}
+}
+
+func (v *definitionValidator) validateVectorFields() {
+	if len(v.def.VectorFields) == 0 {
+		v.errorf("fields: vectorSearch indexes require at least one field")
+		return
+	}
+	hasVector := false
+	for i, field := range v.def.VectorFields {
+		attr := fmt.Sprintf("fields[%d]", i)
+		fieldType, _ := field["type"].(string)
+		if fieldType == "" {
+			v.errorf("%s: type is required", attr)
+			continue
+		}
+		if !slices.Contains(vectorSearchFieldTypes, fieldType) {
+			v.warnf("%s: unknown field type %q, known types are %s", attr, fieldType, strings.Join(vectorSearchFieldTypes, ", "))
+			hasVector = true // It may be a new type of vector field.
+			continue
+		}
+		if path, _ := field["path"].(string); path == "" {
+			v.errorf("%s: path is required", attr)
+		}
+		if fieldType == "filter" {
+			continue
+		}
+		hasVector = true
+		if fieldType != "vector" {
+			continue
+		}
+		dimensions, ok := field["numDimensions"].(float64)
+		if !ok || dimensions != float64(int(dimensions)) || dimensions < 1 || dimensions > maxVectorDimensions {
+			v.errorf("%s.numDimensions: must be an integer between 1 and %d, got %v", attr, maxVectorDimensions, field["numDimensions"])
+		}
+		v.validateOneOf(attr+".similarity", field["similarity"], vectorSimilarities)
+		if quantization, ok := field["quantization"].(string); ok && !slices.Contains(vectorQuantizations, quantization) {
+			v.warnf("%s.quantization: unknown quantization %q, known quantizations are %s", attr, quantization, strings.Join(vectorQuantizations, ", "))
+		}
+	}
+	if !hasVector {
+		v.errorf("fields: vectorSearch indexes require at least one field of type vector or autoEmbed")
+	}
+}
+
+func (v *definitionValidator) validateOneOf(attr string, value any, valid []string) {
+	if s, ok := value.(string); !ok || !slices.Contains(valid, s) {
+		v.errorf("%s: must be one of %s, got %v", attr, strings.Join(valid, ", "), value)
+	}
+}
+
+// validateStoredSource accepts a boolean or an object with an include or exclude list of field names.
+func (v *definitionValidator) validateStoredSource() {
+	obj, ok := v.def.StoredSource.(map[string]any)
+	if !ok {
+		return // Booleans are parsed by UnmarshalStoredSource, other JSON values are rejected by Atlas with a clear error.
+	}
+	if len(obj) != 1 {
+		v.errorf("stored_source: must contain either include or exclude")
+		return
+	}
+	for key, value := range obj {
+		if key != "include" && key != "exclude" {
+			v.errorf("stored_source: unknown key %q, valid keys are include and exclude", key)
+			continue
+		}
+		list, ok := value.([]any)
+		if !ok || slices.ContainsFunc(list, func(item any) bool { _, isString := item.(string); return !isString }) {
+			v.errorf("stored_source.%s: must be an array of field names", key)
+		}
+	}
+}
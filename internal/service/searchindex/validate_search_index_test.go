@@ -0,0 +1,176 @@
+package searchindex_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/searchindex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDefinition(t *testing.T) {
+	testCases := map[string]struct {
+		def              searchindex.IndexDefinitionForTest
+		mappingsFields   string
+		vectorFields     string
+		storedSource     string
+		expectedErrors   []string
+		expectedWarnings []string
+	}{
+		"valid search index": {
+			def: searchindex.IndexDefinitionForTest{
+				Analyzer:         "lucene.standard",
+				SearchAnalyzer:   "custom",
+				SynonymAnalyzers: []string{"lucene.english"},
+				CustomAnalyzers:  []string{"custom"},
+			},
+			mappingsFields: `{
+				"title": [{"type": "string", "analyzer": "custom", "multi": {"english": {"type": "string", "analyzer": "lucene.english"}}}, {"type": "autocomplete"}],
+				"address": {"type": "document", "fields": {"city": {"type": "token"}}},
+				"embedding": {"type": "knnVector", "dimensions": 1536, "similarity": "cosine"}
+			}`,
+			storedSource: `{"include": ["title"]}`,
+		},
+		"invalid search index": {
+			def: searchindex.IndexDefinitionForTest{
+				Analyzer:         "lucene.unknown",
+				SynonymAnalyzers: []string{"missing"},
+			},
+			mappingsFields: `{
+				"title": [{"type": "text"}, {"type": "string", "searchAnalyzer": "missing"}],
+				"address": {"type": "string", "fields": {"city": {"type": "token"}}},
+				"items": {"type": "embeddedDocuments", "fields": {"price": {"type": "money"}}},
+				"embedding": {"type": "knnVector", "dimensions": 1536, "similarity": "l2"},
+				"other": "string"
+			}`,
+			storedSource: `{"include": ["title"], "exclude": ["address"]}`,
+			expectedErrors: []string{
+				`synonyms.analyzer: analyzer "missing" is not defined in analyzers`,
+				`mappings_fields.title[1].searchAnalyzer: analyzer "missing" is not defined in analyzers`,
+				"mappings_fields.address: fields is only supported in document and embeddedDocuments types",
+				"mappings_fields.embedding.similarity: must be one of euclidean, cosine, dotProduct, got l2",
+				"mappings_fields.other: field definition must be an object or an array of objects",
+				"stored_source: must contain either include or exclude",
+			},
+			expectedWarnings: []string{
+				`analyzer: "lucene.unknown" is not a known built-in analyzer`,
+				`mappings_fields.items.fields.price: unknown field type "money"`,
+				`mappings_fields.title[0]: unknown field type "text"`,
+			},
+		},
+		"unknown values are warnings": {
+			def: searchindex.IndexDefinitionForTest{SearchAnalyzer: "lucene.newLanguage"},
+			mappingsFields: `{
+				"title": {"type": "vectorText", "analyzer": "lucene.standard", "fields": {"nested": {"type": "string"}}},
+				"missingType": {"analyzer": "lucene.standard"}
+			}`,
+			expectedErrors: []string{"mappings_fields.missingType: type is required"},
+			expectedWarnings: []string{
+				`search_analyzer: "lucene.newLanguage" is not a known built-in analyzer`,
+				`mappings_fields.title: unknown field type "vectorText"`,
+			},
+		},
+		"valid vector search index": {
+			def: searchindex.IndexDefinitionForTest{Type: "vectorSearch"},
+			vectorFields: `[
+				{"type": "vector", "path": "plot_embedding", "numDimensions": 1536, "similarity": "euclidean", "quantization": "scalar"},
+				{"type": "filter", "path": "genres"}
+			]`,
+		},
+		"invalid vector search index": {
+			def: searchindex.IndexDefinitionForTest{Type: "vectorSearch"},
+			vectorFields: `[
+				{"type": "vector", "path": "plot_embedding", "numDimensions": 10000, "similarity": "cosine", "quantization": "product"},
+				{"type": "vector", "numDimensions": 1.5},
+				{"type": "knnVector", "path": "embedding"}
+			]`,
+			storedSource: `{"include": "title"}`,
+			expectedErrors: []string{
+				"fields[0].numDimensions: must be an integer between 1 and 8192, got 10000",
+				"fields[1]: path is required",
+				"fields[1].numDimensions: must be an integer between 1 and 8192, got 1.5",
+				"fields[1].similarity: must be one of euclidean, cosine, dotProduct, got <nil>",
+				"stored_source.include: must be an array of field names",
+			},
+			expectedWarnings: []string{
+				`fields[0].quantization: unknown quantization "product"`,
+				`fields[2]: unknown field type "knnVector"`,
+			},
+		},
+		"unknown vector field type counts as a vector field": {
+			def:              searchindex.IndexDefinitionForTest{Type: "vectorSearch"},
+			vectorFields:     `[{"type": "newVector", "path": "embedding"}, {"type": "filter", "path": "genres"}]`,
+			expectedWarnings: []string{`fields[0]: unknown field type "newVector", known types are vector, filter, autoEmbed`},
+		},
+		"vector search index without vector fields": {
+			def:            searchindex.IndexDefinitionForTest{Type: "vectorSearch"},
+			vectorFields:   `[{"type": "filter", "path": "genres"}]`,
+			expectedErrors: []string{"fields: vectorSearch indexes require at least one field of type vector or autoEmbed"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			def := tc.def
+			if tc.mappingsFields != "" {
+				require.NoError(t, json.Unmarshal([]byte(tc.mappingsFields), &def.MappingsFields))
+			}
+			if tc.vectorFields != "" {
+				require.NoError(t, json.Unmarshal([]byte(tc.vectorFields), &def.VectorFields))
+			}
+			if tc.storedSource != "" {
+				require.NoError(t, json.Unmarshal([]byte(tc.storedSource), &def.StoredSource))
+			}
+			warnings, err := searchindex.ValidateDefinitionForTest(&def)
+			require.Len(t, warnings, len(tc.expectedWarnings), "warnings: %v", warnings)
+			for i, expected := range tc.expectedWarnings {
+				assert.Contains(t, warnings[i], expected)
+			}
+			if len(tc.expectedErrors) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, expected := range tc.expectedErrors {
+				assert.Contains(t, err.Error(), expected)
+			}
+		})
+	}
+}
+
+func TestMappingsFieldsStructured(t *testing.T) {
+	elements := []any{
+		map[string]any{"name": "title", "type": "string", "analyzer": "lucene.english", "search_analyzer": "", "options": `{"multi": {"keyword": {"type": "string", "analyzer": "lucene.keyword"}}}`, "fields": ""},
+		map[string]any{"name": "title", "type": "autocomplete", "analyzer": "", "search_analyzer": "", "options": `{"minGrams": 2, "maxGrams": 15}`, "fields": ""},
+		map[string]any{"name": "address", "type": "document", "analyzer": "", "search_analyzer": "", "options": `{"dynamic": false}`, "fields": `{"city": {"type": "token"}}`},
+	}
+	fields, err := searchindex.MappingsFieldsFromStructuredForTest(elements)
+	require.NoError(t, err)
+	expected := map[string]any{
+		"title": []any{
+			map[string]any{"type": "string", "analyzer": "lucene.english", "multi": map[string]any{"keyword": map[string]any{"type": "string", "analyzer": "lucene.keyword"}}},
+			map[string]any{"type": "autocomplete", "minGrams": float64(2), "maxGrams": float64(15)},
+		},
+		"address": map[string]any{"type": "document", "dynamic": false, "fields": map[string]any{"city": map[string]any{"type": "token"}}},
+	}
+	assert.Equal(t, expected, fields)
+
+	flattened, err := searchindex.FlattenMappingsFieldsStructuredForTest(fields)
+	require.NoError(t, err)
+	require.Len(t, flattened, 3)
+	assert.Equal(t, "address", flattened[0]["name"])
+	assert.JSONEq(t, `{"city": {"type": "token"}}`, flattened[0]["fields"].(string))
+	assert.JSONEq(t, `{"maxGrams": 15, "minGrams": 2}`, flattened[2]["options"].(string))
+	roundTrip := make([]any, len(flattened))
+	for i, element := range flattened {
+		roundTrip[i] = element
+	}
+	fieldsRoundTrip, err := searchindex.MappingsFieldsFromStructuredForTest(roundTrip)
+	require.NoError(t, err)
+	assert.Equal(t, expected, fieldsRoundTrip)
+
+	_, err = searchindex.MappingsFieldsFromStructuredForTest([]any{
+		map[string]any{"name": "title", "type": "string", "options": `{"analyzer": "lucene.english"}`},
+	})
+	assert.ErrorContains(t, err, "can't contain analyzer, use analyzer instead")
+}
@@ -7,8 +7,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
@@ -30,6 +32,9 @@ const (
 
 var _ resource.ResourceWithConfigure = &streamConnectionRS{}
 var _ resource.ResourceWithImportState = &streamConnectionRS{}
+var _ resource.ResourceWithIdentity = &streamConnectionRS{}
+var _ config.ResourceWithIdentityFromState = &streamConnectionRS{}
+var _ config.ResourceWithImportStateFromIdentity = &streamConnectionRS{}
 
 func Resource() resource.Resource {
 	return &streamConnectionRS{
@@ -195,6 +200,10 @@ func (r *streamConnectionRS) Schema(ctx context.Context, req resource.SchemaRequ
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *streamConnectionRS) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(ResourceSchema(ctx), "project_id", "workspace_name", "connection_name")
+}
+
 // getWorkspaceOrInstanceName returns the workspace name from workspace_name or instance_name field
 func getWorkspaceOrInstanceName(model *TFStreamConnectionModel) string {
 	if !model.WorkspaceName.IsNull() && !model.WorkspaceName.IsUnknown() {
@@ -379,6 +388,26 @@ func (r *streamConnectionRS) ImportState(ctx context.Context, req resource.Impor
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_name"), connectionName)...)
 }
 
+// IdentityFromState is needed because workspace_name is null in the state when the deprecated instance_name is used.
+func (r *streamConnectionRS) IdentityFromState(ctx context.Context, state *tfsdk.State) (map[string]string, diag.Diagnostics) {
+	var model TFStreamConnectionModel
+	diags := state.Get(ctx, &model)
+	return map[string]string{
+		"project_id":      model.ProjectID.ValueString(),
+		"workspace_name":  getWorkspaceOrInstanceName(&model),
+		"connection_name": model.ConnectionName.ValueString(),
+	}, diags
+}
+
+func (r *streamConnectionRS) ImportStateFromIdentity(ctx context.Context, identity map[string]string, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(state.SetAttribute(ctx, path.Root("instance_name"), identity["workspace_name"])...)
+	diags.Append(state.SetAttribute(ctx, path.Root("workspace_name"), identity["workspace_name"])...)
+	diags.Append(state.SetAttribute(ctx, path.Root("project_id"), identity["project_id"])...)
+	diags.Append(state.SetAttribute(ctx, path.Root("connection_name"), identity["connection_name"])...)
+	return diags
+}
+
 func splitStreamConnectionImportID(id string) (workspaceName, projectID, connectionName string, err error) {
 	var re = regexp.MustCompile(`^(.*)-([0-9a-fA-F]{24})-(.*)$`)
 	parts := re.FindStringSubmatch(id)
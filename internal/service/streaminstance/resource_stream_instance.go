@@ -15,6 +15,7 @@ import (
 
 var _ resource.ResourceWithConfigure = &streamInstanceRS{}
 var _ resource.ResourceWithImportState = &streamInstanceRS{}
+var _ resource.ResourceWithIdentity = &streamInstanceRS{}
 
 const streamInstanceName = "stream_instance"
 
@@ -35,6 +36,10 @@ func (r *streamInstanceRS) Schema(ctx context.Context, req resource.SchemaReques
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *streamInstanceRS) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(ResourceSchema(), "project_id", "instance_name")
+}
+
 func (r *streamInstanceRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var streamInstancePlan TFStreamInstanceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &streamInstancePlan)...)
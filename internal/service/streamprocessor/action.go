@@ -0,0 +1,172 @@
+package streamprocessor
+
+import (
+	"context"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	StartActionName = "stream_processor_start"
+	StopActionName  = "stream_processor_stop"
+)
+
+var _ action.ActionWithConfigure = &startAction{}
+var _ action.ActionWithConfigure = &stopAction{}
+
+func StartAction() action.Action {
+	return &startAction{
+		ActionCommon: config.ActionCommon{
+			ActionName: StartActionName,
+		},
+	}
+}
+
+func StopAction() action.Action {
+	return &stopAction{
+		ActionCommon: config.ActionCommon{
+			ActionName: StopActionName,
+		},
+	}
+}
+
+type startAction struct {
+	config.ActionCommon
+}
+
+type stopAction struct {
+	config.ActionCommon
+}
+
+type TFStreamProcessorActionModel struct {
+	ProjectID     types.String `tfsdk:"project_id"`
+	WorkspaceName types.String `tfsdk:"workspace_name"`
+	ProcessorName types.String `tfsdk:"processor_name"`
+}
+
+type TFStreamProcessorStartActionModel struct {
+	TFStreamProcessorActionModel
+	Tier types.String `tfsdk:"tier"`
+}
+
+func actionSchema(withTier bool) schema.Schema {
+	attrs := map[string]schema.Attribute{
+		"project_id": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project, also known as `groupId` in the official documentation.",
+		},
+		"workspace_name": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Label that identifies the stream processing workspace.",
+		},
+		"processor_name": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Label that identifies the stream processor.",
+		},
+	}
+	if withTier {
+		attrs["tier"] = schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Selected tier to start the stream processor on rather than defaulting to the workspace setting. Configures Memory / VCPU allowances. Valid options are SP2, SP5, SP10, SP30, and SP50.",
+		}
+	}
+	return schema.Schema{Attributes: attrs}
+}
+
+func (a *startAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionSchema(true)
+	resp.Schema.MarkdownDescription = "Starts a stream processor and waits until it reaches the `STARTED` state. Nothing is done if the stream processor is already started."
+}
+
+func (a *stopAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionSchema(false)
+	resp.Schema.MarkdownDescription = "Stops a stream processor and waits until it reaches the `STOPPED` state. Nothing is done if the stream processor is not started."
+}
+
+func (a *startAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model TFStreamProcessorStartActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api := a.Client.AtlasV2.StreamsAPI
+	requestParams := newActionRequestParams(&model.TFStreamProcessorActionModel)
+	currentState := getActionProcessorState(ctx, api, requestParams, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if currentState == StartedState {
+		config.SendProgress(resp, "Stream processor %s is already started", requestParams.ProcessorName)
+		return
+	}
+	startWithOptions := &admin.StreamsStartStreamProcessorWith{}
+	if model.Tier.ValueString() != "" {
+		startWithOptions.SetTier(model.Tier.ValueString())
+	}
+	_, err := api.StartStreamProcessorWith(ctx, requestParams.GroupId, requestParams.TenantName, requestParams.ProcessorName, startWithOptions).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error starting stream processor", err.Error())
+		return
+	}
+	config.SendProgress(resp, "Waiting for stream processor %s to start", requestParams.ProcessorName)
+	if _, err := WaitStateTransition(ctx, requestParams, api, []string{InitiatingState, CreatingState, CreatedState, StoppedState}, []string{StartedState}); err != nil {
+		resp.Diagnostics.AddError("Error changing state of stream processor", err.Error())
+	}
+}
+
+func (a *stopAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var model TFStreamProcessorActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api := a.Client.AtlasV2.StreamsAPI
+	requestParams := newActionRequestParams(&model)
+	currentState := getActionProcessorState(ctx, api, requestParams, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if currentState != StartedState {
+		config.SendProgress(resp, "Stream processor %s is not started, current state is %s", requestParams.ProcessorName, currentState)
+		return
+	}
+	_, err := api.StopStreamProcessorWithParams(ctx,
+		&admin.StopStreamProcessorApiParams{
+			GroupId:       requestParams.GroupId,
+			TenantName:    requestParams.TenantName,
+			ProcessorName: requestParams.ProcessorName,
+		},
+	).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error stopping stream processor", err.Error())
+		return
+	}
+	config.SendProgress(resp, "Waiting for stream processor %s to stop", requestParams.ProcessorName)
+	if _, err := WaitStateTransition(ctx, requestParams, api, []string{StartedState}, []string{StoppedState}); err != nil {
+		resp.Diagnostics.AddError("Error changing state of stream processor", err.Error())
+	}
+}
+
+func newActionRequestParams(model *TFStreamProcessorActionModel) *admin.GetStreamProcessorApiParams {
+	return &admin.GetStreamProcessorApiParams{
+		GroupId:       model.ProjectID.ValueString(),
+		TenantName:    model.WorkspaceName.ValueString(),
+		ProcessorName: model.ProcessorName.ValueString(),
+	}
+}
+
+func getActionProcessorState(ctx context.Context, api admin.StreamsAPI, requestParams *admin.GetStreamProcessorApiParams, diags *diag.Diagnostics) string {
+	streamProcessor, _, err := api.GetStreamProcessorWithParams(ctx, requestParams).Execute()
+	if err != nil {
+		diags.AddError("Error reading stream processor", err.Error())
+		return ""
+	}
+	return streamProcessor.GetState()
+}
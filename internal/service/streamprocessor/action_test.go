@@ -0,0 +1,125 @@
+package streamprocessor_test
+
+import (
+	"errors"
+	"maps"
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/atlas-sdk/v20250312023/mockadmin"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprocessor"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/unit"
+)
+
+var actionAttributes = map[string]any{
+	"project_id":     "groupId",
+	"workspace_name": "tenantName",
+	"processor_name": streamProcessorName,
+}
+
+func mockGetStreamProcessorStates(m *mockadmin.StreamsAPI, states ...string) {
+	m.EXPECT().GetStreamProcessorWithParams(mock.Anything, requestParams).Return(admin.GetStreamProcessorApiRequest{ApiService: m})
+	for _, state := range states {
+		m.EXPECT().GetStreamProcessorExecute(mock.Anything).Return(responseWithState(&state), nil, nil).Once()
+	}
+}
+
+func TestStartActionInvoke(t *testing.T) {
+	testCases := map[string]struct {
+		startErr      error
+		expectedTier  *string
+		tier          string
+		expectedError string
+		states        []string
+		expectStart   bool
+	}{
+		"start stopped processor": {
+			states:      []string{StoppedState, StartedState},
+			expectStart: true,
+		},
+		"start with tier": {
+			states:       []string{CreatedState, StartedState},
+			tier:         "SP10",
+			expectedTier: admin.PtrString("SP10"),
+			expectStart:  true,
+		},
+		"already started": {
+			states: []string{StartedState},
+		},
+		"start error": {
+			states:        []string{StoppedState},
+			startErr:      errors.New("start failed"),
+			expectStart:   true,
+			expectedError: "Error starting stream processor",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := mockadmin.NewStreamsAPI(t)
+			mockGetStreamProcessorStates(m, tc.states...)
+			if tc.expectStart {
+				body := &admin.StreamsStartStreamProcessorWith{Tier: tc.expectedTier}
+				m.EXPECT().StartStreamProcessorWith(mock.Anything, "groupId", "tenantName", streamProcessorName, body).Return(admin.StartStreamProcessorWithApiRequest{ApiService: m})
+				m.EXPECT().StartStreamProcessorWithExecute(mock.Anything).Return(nil, tc.startErr)
+			}
+			attributes := maps.Clone(actionAttributes)
+			if tc.tier != "" {
+				attributes["tier"] = tc.tier
+			}
+			resp, _ := unit.InvokeAction(t, streamprocessor.StartAction(), &admin.APIClient{StreamsAPI: m}, attributes)
+			assertActionDiags(t, tc.expectedError, resp.Diagnostics)
+		})
+	}
+}
+
+func TestStopActionInvoke(t *testing.T) {
+	testCases := map[string]struct {
+		stopErr       error
+		expectedError string
+		states        []string
+		expectStop    bool
+	}{
+		"stop started processor": {
+			states:     []string{StartedState, StoppedState},
+			expectStop: true,
+		},
+		"not started": {
+			states: []string{CreatedState},
+		},
+		"stop error": {
+			states:        []string{StartedState},
+			stopErr:       errors.New("stop failed"),
+			expectStop:    true,
+			expectedError: "Error stopping stream processor",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := mockadmin.NewStreamsAPI(t)
+			mockGetStreamProcessorStates(m, tc.states...)
+			if tc.expectStop {
+				stopParams := &admin.StopStreamProcessorApiParams{GroupId: "groupId", TenantName: "tenantName", ProcessorName: streamProcessorName}
+				m.EXPECT().StopStreamProcessorWithParams(mock.Anything, stopParams).Return(admin.StopStreamProcessorApiRequest{ApiService: m})
+				m.EXPECT().StopStreamProcessorExecute(mock.Anything).Return(nil, tc.stopErr)
+			}
+			resp, _ := unit.InvokeAction(t, streamprocessor.StopAction(), &admin.APIClient{StreamsAPI: m}, actionAttributes)
+			assertActionDiags(t, tc.expectedError, resp.Diagnostics)
+		})
+	}
+}
+
+func assertActionDiags(t *testing.T, expectedError string, diags diag.Diagnostics) {
+	t.Helper()
+	if expectedError == "" {
+		unit.AssertDiagsOK(t, diags)
+		return
+	}
+	if assert.Len(t, diags.Errors(), 1) {
+		assert.Equal(t, expectedError, diags.Errors()[0].Summary())
+	}
+}
@@ -3,6 +3,7 @@ package streamprocessor
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -36,8 +37,16 @@ func (d *StreamProccesorDS) Schema(ctx context.Context, req datasource.SchemaReq
 	})
 }
 
-func dataSourceOverridenFields() map[string]dsschema.Attribute {
+// resourceOnlyFields are the resource attributes that only define how changes are applied, so they are not in the data sources.
+func resourceOnlyFields() map[string]dsschema.Attribute {
 	return map[string]dsschema.Attribute{
+		"restart_on_update":      nil,
+		"resume_from_checkpoint": nil,
+	}
+}
+
+func dataSourceOverridenFields() map[string]dsschema.Attribute {
+	fields := map[string]dsschema.Attribute{
 		"instance_name": dsschema.StringAttribute{
 			Optional:            true,
 			MarkdownDescription: "Label that identifies the stream processing workspace.",
@@ -54,6 +63,8 @@ func dataSourceOverridenFields() map[string]dsschema.Attribute {
 			},
 		},
 	}
+	maps.Copy(fields, resourceOnlyFields())
+	return fields
 }
 
 func (d *StreamProccesorDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
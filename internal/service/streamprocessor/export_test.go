@@ -0,0 +1,9 @@
+package streamprocessor
+
+func ValidatePipelineForTest(pipeline string) ([]string, error) {
+	return validatePipeline(pipeline)
+}
+
+func NeedsRestartForTest(plan, state *TFStreamProcessorRSModel, plannedState string) bool {
+	return needsRestart(plan, state, plannedState)
+}
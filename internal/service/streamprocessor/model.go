@@ -4,11 +4,11 @@ import (
 	"context"
 	"encoding/json"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen/customtypes"
 	"go.mongodb.org/atlas-sdk/v20250312023/admin"
 )
 
@@ -95,6 +95,13 @@ func NewStreamProcessorUpdateReq(ctx context.Context, plan *TFStreamProcessorRSM
 		}
 	}
 
+	if !plan.ResumeFromCheckpoint.IsNull() && !plan.ResumeFromCheckpoint.IsUnknown() {
+		if streamProcessorAPIParams.StreamsModifyStreamProcessor.Options == nil {
+			streamProcessorAPIParams.StreamsModifyStreamProcessor.Options = &admin.StreamsModifyStreamProcessorOptions{}
+		}
+		streamProcessorAPIParams.StreamsModifyStreamProcessor.Options.ResumeFromCheckpoint = plan.ResumeFromCheckpoint.ValueBoolPointer()
+	}
+
 	return streamProcessorAPIParams, nil
 }
 
@@ -218,12 +225,12 @@ func convertDlqToTF(ctx context.Context, dlq *admin.StreamsDLQ) (*types.Object,
 	}
 	return &dlqObject, nil
 }
-func convertPipelineToTF(pipeline []any) (jsontypes.Normalized, diag.Diagnostics) {
+func convertPipelineToTF(pipeline []any) (customtypes.JSONValue, diag.Diagnostics) {
 	pipelineJSON, err := json.Marshal(pipeline)
 	if err != nil {
-		return jsontypes.NewNormalizedValue(""), diag.Diagnostics{diag.NewErrorDiagnostic("failed to marshal pipeline", err.Error())}
+		return customtypes.NewJSONValue(""), diag.Diagnostics{diag.NewErrorDiagnostic("failed to marshal pipeline", err.Error())}
 	}
-	return jsontypes.NewNormalizedValue(string(pipelineJSON)), nil
+	return customtypes.NewJSONValue(string(pipelineJSON)), nil
 }
 
 func convertStatsToTF(stats any) (types.String, diag.Diagnostics) {
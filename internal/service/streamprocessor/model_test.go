@@ -4,8 +4,9 @@ import (
 	"encoding/json"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen/customtypes"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/schemafunc"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprocessor"
@@ -250,7 +251,7 @@ func TestSDKToTFModel(t *testing.T) {
 				InstanceName:  types.StringValue(workspaceName),
 				Options:       types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
 				ProcessorID:   types.StringValue(processorID),
-				Pipeline:      jsontypes.NewNormalizedValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
+				Pipeline:      customtypes.NewJSONValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
 				ProcessorName: types.StringValue(processorName),
 				ProjectID:     types.StringValue(projectID),
 				State:         types.StringValue("CREATED"),
@@ -263,7 +264,7 @@ func TestSDKToTFModel(t *testing.T) {
 				InstanceName:  types.StringValue(workspaceName),
 				Options:       types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
 				ProcessorID:   types.StringValue(processorID),
-				Pipeline:      jsontypes.NewNormalizedValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
+				Pipeline:      customtypes.NewJSONValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
 				ProcessorName: types.StringValue(processorName),
 				ProjectID:     types.StringValue(projectID),
 				State:         types.StringValue("STARTED"),
@@ -276,7 +277,7 @@ func TestSDKToTFModel(t *testing.T) {
 				InstanceName:  types.StringValue(workspaceName),
 				Options:       optionsToTFModel(t, &streamOptionsExample),
 				ProcessorID:   types.StringValue(processorID),
-				Pipeline:      jsontypes.NewNormalizedValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
+				Pipeline:      customtypes.NewJSONValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
 				ProcessorName: types.StringValue(processorName),
 				ProjectID:     types.StringValue(projectID),
 				State:         types.StringValue("STARTED"),
@@ -290,7 +291,7 @@ func TestSDKToTFModel(t *testing.T) {
 				InstanceName:    types.StringValue(workspaceName),
 				Options:         types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
 				ProcessorID:     types.StringValue(processorID),
-				Pipeline:        jsontypes.NewNormalizedValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
+				Pipeline:        customtypes.NewJSONValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
 				ProcessorName:   types.StringValue(processorName),
 				ProjectID:       types.StringValue(projectID),
 				State:           types.StringValue("CREATED"),
@@ -411,7 +412,7 @@ func TestPluralDSSDKToTFModelWithInstanceName(t *testing.T) {
 }
 
 func TestNewStreamProcessorUpdateReq(t *testing.T) {
-	validPipeline := jsontypes.NewNormalizedValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]")
+	validPipeline := customtypes.NewJSONValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]")
 
 	testCases := map[string]struct {
 		model               *streamprocessor.TFStreamProcessorRSModel
@@ -514,6 +515,63 @@ func TestNewStreamProcessorUpdateReq(t *testing.T) {
 	}
 }
 
+func TestNewStreamProcessorUpdateReqResumeFromCheckpoint(t *testing.T) {
+	validPipeline := customtypes.NewJSONValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]")
+	dlq, diags := types.ObjectValue(streamprocessor.DlqObjectType.AttrTypes, map[string]attr.Value{
+		"coll":            types.StringValue("coll"),
+		"connection_name": types.StringValue("connectionName"),
+		"db":              types.StringValue("db"),
+	})
+	require.False(t, diags.HasError())
+	options, diags := types.ObjectValue(streamprocessor.OptionsObjectType.AttrTypes, map[string]attr.Value{"dlq": dlq})
+	require.False(t, diags.HasError())
+
+	testCases := map[string]struct {
+		expectedResume       *bool
+		options              types.Object
+		resumeFromCheckpoint types.Bool
+		expectedDlq          bool
+	}{
+		"not set": {
+			resumeFromCheckpoint: types.BoolNull(),
+			options:              types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
+		},
+		"false without options": {
+			resumeFromCheckpoint: types.BoolValue(false),
+			options:              types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
+			expectedResume:       admin.PtrBool(false),
+		},
+		"true with options": {
+			resumeFromCheckpoint: types.BoolValue(true),
+			options:              options,
+			expectedResume:       admin.PtrBool(true),
+			expectedDlq:          true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			updateReq, diags := streamprocessor.NewStreamProcessorUpdateReq(t.Context(), &streamprocessor.TFStreamProcessorRSModel{
+				WorkspaceName:        types.StringValue(workspaceName),
+				Pipeline:             validPipeline,
+				ProcessorName:        types.StringValue(processorName),
+				ProjectID:            types.StringValue(projectID),
+				Options:              tc.options,
+				ResumeFromCheckpoint: tc.resumeFromCheckpoint,
+			})
+			require.False(t, diags.HasError())
+			sdkOptions := updateReq.StreamsModifyStreamProcessor.Options
+			if tc.expectedResume == nil {
+				assert.Nil(t, sdkOptions)
+				return
+			}
+			require.NotNil(t, sdkOptions)
+			assert.Equal(t, tc.expectedResume, sdkOptions.ResumeFromCheckpoint)
+			assert.Equal(t, tc.expectedDlq, sdkOptions.Dlq != nil)
+		})
+	}
+}
+
 func TestGetWorkspaceOrInstanceName(t *testing.T) {
 	testCases := map[string]struct {
 		workspaceName types.String
@@ -568,7 +626,7 @@ func TestPipelineFieldOrderPreserved(t *testing.T) {
 		ProjectID:     types.StringValue(projectID),
 		WorkspaceName: types.StringValue(workspaceName),
 		ProcessorName: types.StringValue(processorName),
-		Pipeline:      jsontypes.NewNormalizedValue(pipeline),
+		Pipeline:      customtypes.NewJSONValue(pipeline),
 	}
 
 	// The whole request body is marshaled, not just the pipeline, so the assertion covers the
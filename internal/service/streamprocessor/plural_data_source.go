@@ -31,6 +31,7 @@ func (d *streamProcessorsDS) Schema(ctx context.Context, req datasource.SchemaRe
 	resp.Schema = conversion.PluralDataSourceSchemaFromResource(ResourceSchema(ctx), &conversion.PluralDataSourceSchemaRequest{
 		RequiredFields:      []string{"project_id"},
 		OverrideResultsDoc:  "Returns all Stream Processors within the specified stream instance.\n\nTo use this resource, the requesting API Key must have the Project Owner\n\nrole or Project Stream Processing Owner role.",
+		OverridenFields:     resourceOnlyFields(),
 		OverridenRootFields: dataSourceOverridenFields(),
 	})
 }
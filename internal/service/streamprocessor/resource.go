@@ -3,15 +3,24 @@ package streamprocessor
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"regexp"
+	"slices"
 
 	"go.mongodb.org/atlas-sdk/v20250312023/admin"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/cleanup"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 )
@@ -20,11 +29,20 @@ const StreamProcessorName = "stream_processor"
 
 var _ resource.ResourceWithConfigure = &streamProcessorRS{}
 var _ resource.ResourceWithImportState = &streamProcessorRS{}
+var _ resource.ResourceWithIdentity = &streamProcessorRS{}
+var _ resource.ResourceWithModifyPlan = &streamProcessorRS{}
+var _ config.ResourceWithIdentityFromState = &streamProcessorRS{}
+var _ config.ResourceWithImportStateFromIdentity = &streamProcessorRS{}
 
 const (
 	errorCreateStartActions    = "You need to fix the processor and import the resource or delete it manually and re-run terraform apply."
 	errorCreateStart           = "Error starting stream processor. " + errorCreateStartActions
 	errorCreateStartTransition = "Error changing state of stream processor. " + errorCreateStartActions
+	errorInvalidPipeline       = "Invalid stream processor pipeline"
+	warningUnknownConnection   = "Stream connection not found"
+	errorRestartDisabled       = "Stream processor restart required"
+	errorRestartDisabledDetail = "Stream processor %s is started and must be stopped to apply the changes, but restart_on_update is false. Set state to STOPPED to stop it or set restart_on_update to true."
+	errorRestartActions        = "The stream processor was stopped to apply the changes, set state to STOPPED or fix the error and run terraform apply again."
 )
 
 func Resource() resource.Resource {
@@ -44,6 +62,79 @@ func (r *streamProcessorRS) Schema(ctx context.Context, req resource.SchemaReque
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *streamProcessorRS) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(ResourceSchema(ctx), "project_id", "workspace_name", "processor_name")
+}
+
+// ModifyPlan validates the stages of the pipeline and that the stream connections it references exist in the workspace,
+// so errors are reported during plan instead of when the processor is created or started.
+// It also fails the plan if a started processor needs to be restarted to apply the changes and restart_on_update is false.
+func (r *streamProcessorRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan TFStreamProcessorRSModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if req.State.Raw.IsNull() {
+		r.validatePipelinePlan(ctx, &plan, &resp.Diagnostics)
+		return
+	}
+	var state TFStreamProcessorRSModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plannedState := plan.State.ValueString()
+	if plan.State.IsUnknown() || plannedState == "" {
+		plannedState = state.State.ValueString()
+	}
+	if !restartOnUpdate(&plan) && needsRestart(&plan, &state, plannedState) {
+		resp.Diagnostics.AddError(errorRestartDisabled, fmt.Sprintf(errorRestartDisabledDetail, plan.ProcessorName.ValueString()))
+		return
+	}
+	if !plan.Pipeline.Equal(state.Pipeline) || !plan.Options.Equal(state.Options) {
+		r.validatePipelinePlan(ctx, &plan, &resp.Diagnostics)
+	}
+}
+
+func (r *streamProcessorRS) validatePipelinePlan(ctx context.Context, plan *TFStreamProcessorRSModel, diags *diag.Diagnostics) {
+	if plan.Pipeline.IsUnknown() || plan.Pipeline.IsNull() {
+		return
+	}
+	connectionNames, err := validatePipeline(plan.Pipeline.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("pipeline"), errorInvalidPipeline, err.Error())
+		return
+	}
+	dlqConnectionName, localDiags := dlqConnectionName(ctx, plan.Options)
+	diags.Append(localDiags...)
+	if diags.HasError() {
+		return
+	}
+	workspaceOrInstanceName := GetWorkspaceOrInstanceName(plan.WorkspaceName, plan.InstanceName)
+	if plan.ProjectID.IsUnknown() || workspaceOrInstanceName == "" {
+		return
+	}
+	existingNames, err := streamConnectionNames(ctx, r.Client.AtlasV2, plan.ProjectID.ValueString(), workspaceOrInstanceName)
+	if err != nil {
+		return // The workspace can be created in the same apply, errors are reported when the processor is created.
+	}
+	// Connections can also be created in the same apply, so missing connections are reported as warnings.
+	for _, name := range connectionNames {
+		if !slices.Contains(existingNames, name) {
+			diags.AddAttributeWarning(path.Root("pipeline"), warningUnknownConnection,
+				fmt.Sprintf("Connection %q referenced by the pipeline doesn't exist in workspace %s. Ignore this warning if the connection is created in the same apply.", name, workspaceOrInstanceName))
+		}
+	}
+	if dlqConnectionName != "" && !slices.Contains(existingNames, dlqConnectionName) {
+		diags.AddAttributeWarning(path.Root("options").AtName("dlq").AtName("connection_name"), warningUnknownConnection,
+			fmt.Sprintf("Connection %q doesn't exist in workspace %s. Ignore this warning if the connection is created in the same apply.", dlqConnectionName, workspaceOrInstanceName))
+	}
+}
+
 func (r *streamProcessorRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan TFStreamProcessorRSModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -131,6 +222,8 @@ func (r *streamProcessorRS) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	newStreamProcessorModel.RestartOnUpdate = plan.RestartOnUpdate
+	newStreamProcessorModel.ResumeFromCheckpoint = plan.ResumeFromCheckpoint
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamProcessorModel)...)
 }
 
@@ -163,6 +256,8 @@ func (r *streamProcessorRS) Read(ctx context.Context, req resource.ReadRequest,
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	newStreamProcessorModel.RestartOnUpdate = state.RestartOnUpdate
+	newStreamProcessorModel.ResumeFromCheckpoint = state.ResumeFromCheckpoint
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamProcessorModel)...)
 }
 
@@ -200,8 +295,20 @@ func (r *streamProcessorRS) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	// we must stop the current stream processor if the current state is started
-	if currentState == StartedState {
+	restart := needsRestart(&plan, &state, plannedState)
+	if restart && !restartOnUpdate(&plan) {
+		resp.Diagnostics.AddError(errorRestartDisabled, fmt.Sprintf(errorRestartDisabledDetail, processorName))
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// we must stop the current stream processor if it's started and it needs to be stopped or modified
+	if currentState == StartedState && (plannedState != StartedState || restart) {
 		_, err := connV2.StreamsAPI.StopStreamProcessorWithParams(ctx,
 			&admin.StopStreamProcessorApiParams{
 				GroupId:       plan.ProjectID.ValueString(),
@@ -215,7 +322,7 @@ func (r *streamProcessorRS) Update(ctx context.Context, req resource.UpdateReque
 		}
 
 		// wait for transition from started to stopped
-		_, err = WaitStateTransition(ctx, requestParams, r.Client.AtlasV2.StreamsAPI, []string{StartedState}, []string{StoppedState})
+		_, err = WaitStateTransitionWithTimeout(ctx, requestParams, connV2.StreamsAPI, []string{StartedState}, []string{StoppedState}, updateTimeout)
 		if err != nil {
 			resp.Diagnostics.AddError("Error changing state of stream processor", err.Error())
 			return
@@ -223,41 +330,45 @@ func (r *streamProcessorRS) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	// modify the stream processor
-	modifyAPIRequestParams, diags := NewStreamProcessorUpdateReq(ctx, &plan)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-		return
-	}
-	streamProcessorResp, _, err := r.Client.AtlasV2.StreamsAPI.UpdateStreamProcessorWithParams(ctx, modifyAPIRequestParams).Execute()
-	if err != nil {
-		resp.Diagnostics.AddError("Error modifying stream processor", err.Error())
-		return
+	if needsModification(&plan, &state) {
+		modifyAPIRequestParams, diags := NewStreamProcessorUpdateReq(ctx, &plan)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		var err error
+		streamProcessorResp, _, err = connV2.StreamsAPI.UpdateStreamProcessorWithParams(ctx, modifyAPIRequestParams).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError("Error modifying stream processor", errorMessageAfterStop(err, restart))
+			return
+		}
 	}
 
-	// start the stream processor if the desired state is started
-	if plannedState == StartedState {
+	// start the stream processor if the desired state is started and it's not running
+	if plannedState == StartedState && (currentState != StartedState || restart) {
 		startWithOptions := &admin.StreamsStartStreamProcessorWith{}
 		if plan.Tier.ValueString() != "" {
 			startWithOptions.SetTier(plan.Tier.ValueString())
 		}
 
-		_, err := r.Client.AtlasV2.StreamsAPI.StartStreamProcessorWith(ctx, projectID, workspaceOrInstanceName, processorName, startWithOptions).Execute()
+		_, err := connV2.StreamsAPI.StartStreamProcessorWith(ctx, projectID, workspaceOrInstanceName, processorName, startWithOptions).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError("Error starting stream processor", err.Error())
+			resp.Diagnostics.AddError("Error starting stream processor", errorMessageAfterStop(err, restart))
 			return
 		}
 
-		// wait for transition from stopped to started
-		streamProcessorResp, err = WaitStateTransition(ctx, requestParams, r.Client.AtlasV2.StreamsAPI, []string{StoppedState}, []string{StartedState})
+		// wait for transition to started
+		streamProcessorResp, err = WaitStateTransitionWithTimeout(ctx, requestParams, connV2.StreamsAPI, []string{CreatedState, StoppedState}, []string{StartedState}, updateTimeout)
 		if err != nil {
-			resp.Diagnostics.AddError("Error changing state of stream processor", err.Error())
+			resp.Diagnostics.AddError("Error changing state of stream processor", errorMessageAfterStop(err, restart))
 			return
 		}
 	}
 
-	// Get the current state if the processor was not restarted
+	// Get the current state if the processor was not modified or started
 	if streamProcessorResp == nil {
-		streamProcessorResp, _, err = r.Client.AtlasV2.StreamsAPI.GetStreamProcessorWithParams(ctx, requestParams).Execute()
+		var err error
+		streamProcessorResp, _, err = connV2.StreamsAPI.GetStreamProcessorWithParams(ctx, requestParams).Execute()
 		if err != nil {
 			resp.Diagnostics.AddError("Error reading updated stream processor", err.Error())
 			return
@@ -271,9 +382,42 @@ func (r *streamProcessorRS) Update(ctx context.Context, req resource.UpdateReque
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	newStreamProcessorModel.RestartOnUpdate = plan.RestartOnUpdate
+	newStreamProcessorModel.ResumeFromCheckpoint = plan.ResumeFromCheckpoint
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamProcessorModel)...)
 }
 
+// needsModification returns true if the stream processor must be modified in Atlas to apply the plan.
+func needsModification(plan, state *TFStreamProcessorRSModel) bool {
+	return changed(plan.Pipeline, state.Pipeline) || changed(plan.Options, state.Options) || changed(plan.FailoverEnabled, state.FailoverEnabled)
+}
+
+// needsRestart returns true if a started stream processor that stays started must be stopped to apply the plan:
+// Atlas only modifies stopped processors and the tier is only set when the processor is started.
+func needsRestart(plan, state *TFStreamProcessorRSModel, plannedState string) bool {
+	if state.State.ValueString() != StartedState || plannedState != StartedState {
+		return false
+	}
+	return needsModification(plan, state) || (plan.Tier.ValueString() != "" && changed(plan.Tier, state.Tier))
+}
+
+// changed returns true if the planned value is known and different from the state value.
+func changed(planValue, stateValue attr.Value) bool {
+	return !planValue.IsUnknown() && !planValue.Equal(stateValue)
+}
+
+// restartOnUpdate returns the value of restart_on_update, which is true by default.
+func restartOnUpdate(plan *TFStreamProcessorRSModel) bool {
+	return plan.RestartOnUpdate.IsNull() || plan.RestartOnUpdate.IsUnknown() || plan.RestartOnUpdate.ValueBool()
+}
+
+func errorMessageAfterStop(err error, stopped bool) string {
+	if stopped {
+		return err.Error() + "\n" + errorRestartActions
+	}
+	return err.Error()
+}
+
 func (r *streamProcessorRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var streamProcessorState *TFStreamProcessorRSModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &streamProcessorState)...)
@@ -302,6 +446,26 @@ func (r *streamProcessorRS) ImportState(ctx context.Context, req resource.Import
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("processor_name"), processorName)...)
 }
 
+// IdentityFromState is needed because workspace_name is null in the state when the deprecated instance_name is used.
+func (r *streamProcessorRS) IdentityFromState(ctx context.Context, state *tfsdk.State) (map[string]string, diag.Diagnostics) {
+	var model TFStreamProcessorRSModel
+	diags := state.Get(ctx, &model)
+	return map[string]string{
+		"project_id":     model.ProjectID.ValueString(),
+		"workspace_name": GetWorkspaceOrInstanceName(model.WorkspaceName, model.InstanceName),
+		"processor_name": model.ProcessorName.ValueString(),
+	}, diags
+}
+
+func (r *streamProcessorRS) ImportStateFromIdentity(ctx context.Context, identity map[string]string, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(state.SetAttribute(ctx, path.Root("project_id"), identity["project_id"])...)
+	diags.Append(state.SetAttribute(ctx, path.Root("instance_name"), identity["workspace_name"])...)
+	diags.Append(state.SetAttribute(ctx, path.Root("workspace_name"), identity["workspace_name"])...)
+	diags.Append(state.SetAttribute(ctx, path.Root("processor_name"), identity["processor_name"])...)
+	return diags
+}
+
 func splitImportID(id string) (projectID, workspaceName, processorName *string, err error) {
 	var re = regexp.MustCompile(`^(.*)-([0-9a-fA-F]{24})-(.*)$`)
 	parts := re.FindStringSubmatch(id)
@@ -317,3 +481,41 @@ func splitImportID(id string) (projectID, workspaceName, processorName *string,
 
 	return
 }
+
+func dlqConnectionName(ctx context.Context, options types.Object) (string, diag.Diagnostics) {
+	if options.IsNull() || options.IsUnknown() {
+		return "", nil
+	}
+	optionsModel := &TFOptionsModel{}
+	if diags := options.As(ctx, optionsModel, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return "", diags
+	}
+	if optionsModel.Dlq.IsNull() || optionsModel.Dlq.IsUnknown() {
+		return "", nil
+	}
+	dlqModel := &TFDlqModel{}
+	if diags := optionsModel.Dlq.As(ctx, dlqModel, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return "", diags
+	}
+	return dlqModel.ConnectionName.ValueString(), nil
+}
+
+func streamConnectionNames(ctx context.Context, connV2 *admin.APIClient, projectID, workspaceOrInstanceName string) ([]string, error) {
+	params := admin.ListStreamConnectionsApiParams{
+		GroupId:    projectID,
+		TenantName: workspaceOrInstanceName,
+	}
+	connections, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.StreamsConnection], *http.Response, error) {
+		request := connV2.StreamsAPI.ListStreamConnectionsWithParams(ctx, &params)
+		request = request.PageNum(pageNum)
+		return request.Execute()
+	})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(connections))
+	for i := range connections {
+		names = append(names, connections[i].GetName())
+	}
+	return names, nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -14,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen/customtypes"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/customplanmodifier"
 )
@@ -48,7 +48,7 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 			"pipeline": schema.StringAttribute{
-				CustomType: jsontypes.NormalizedType{},
+				CustomType: customtypes.JSONType{},
 				Required:   true,
 				MarkdownDescription: "Stream aggregation pipeline you want to apply to your streaming data, as a JSON string. [MongoDB Atlas Docs](https://www.mongodb.com/docs/atlas/atlas-stream-processing/stream-aggregation/#std-label-stream-aggregation)" +
 					" contain more information. For more details see the [Aggregation Pipelines Documentation](https://www.mongodb.com/docs/atlas/atlas-stream-processing/stream-aggregation/)." +
@@ -66,7 +66,7 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 				Optional: true,
 				Computed: true,
 				MarkdownDescription: "The state of the stream processor. Commonly occurring states are 'CREATED', 'STARTED', 'STOPPED' and 'FAILED'. Used to start or stop the Stream Processor. Valid values are `CREATED`, `STARTED` or `STOPPED`." +
-					" When a Stream Processor is created without specifying the state, it will default to `CREATED` state. When a Stream Processor is updated without specifying the state, it will default to the Previous state. \n\n**NOTE** When a Stream Processor is updated without specifying the state, it is stopped and then restored to previous state upon update completion.",
+					" When a Stream Processor is created without specifying the state, it will default to `CREATED` state. When a Stream Processor is updated without specifying the state, it will default to the Previous state. \n\n**NOTE** When the `pipeline`, `options`, `failover_enabled` or `tier` of a started Stream Processor are updated without specifying the state, it is stopped and then started again upon update completion, see `restart_on_update`.",
 			},
 			"options": schema.SingleNestedAttribute{
 				Optional:            true,
@@ -104,6 +104,8 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create:            true,
 				CreateDescription: constant.TimeoutDescriptionCreateUpdate(constant.DefaultTimeoutDocumentation),
+				Update:            true,
+				UpdateDescription: "Time to wait for the stream processor to stop and start again when it's updated. " + constant.TimeoutDescriptionCreateUpdate("5m"),
 			}),
 			"delete_on_create_timeout": schema.BoolAttribute{
 				Computed: true,
@@ -113,6 +115,14 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 				},
 				MarkdownDescription: "Indicates whether to delete the resource being created if a timeout is reached when waiting for completion. When set to `true` and timeout occurs, it triggers the deletion and returns immediately without waiting for deletion to complete. When set to `false`, the timeout will not trigger resource deletion. If you suspect a transient error when the value is `true`, wait before retrying to allow resource deletion to finish. Default is `true`.",
 			},
+			"restart_on_update": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Indicates whether the provider can stop a `STARTED` stream processor to modify its `pipeline`, `options`, `failover_enabled` or `tier`, and start it again once the modification is applied, as Atlas only modifies stopped processors. When `false`, the plan fails if any of these attributes change while the processor is started, so it's never stopped without an explicit `state` change. Default is `true`.",
+			},
+			"resume_from_checkpoint": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Indicates whether the stream processor resumes from its last checkpoint when it's started after a modification of `pipeline` or `options`. When `false`, the checkpoint is discarded and the processor starts processing as a new processor. If not set, the Atlas default is used.",
+			},
 			"failover_enabled": schema.BoolAttribute{
 				Optional:            true,
 				MarkdownDescription: "Indicates whether this stream processor is eligible for failover. When `true`, an operator can trigger a failover event to migrate the stream processor to a secondary region configured in the workspace's `failover_regions`. Requires an Atlas-to-Atlas or Atlas-to-Kafka pipeline with `failover_regions` configured on the workspace.",
@@ -122,19 +132,21 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type TFStreamProcessorRSModel struct {
-	InstanceName          types.String         `tfsdk:"instance_name"`
-	WorkspaceName         types.String         `tfsdk:"workspace_name"`
-	Options               types.Object         `tfsdk:"options"`
-	Pipeline              jsontypes.Normalized `tfsdk:"pipeline"`
-	ProcessorID           types.String         `tfsdk:"id"`
-	ProcessorName         types.String         `tfsdk:"processor_name"`
-	ProjectID             types.String         `tfsdk:"project_id"`
-	State                 types.String         `tfsdk:"state"`
-	Stats                 types.String         `tfsdk:"stats"`
-	Tier                  types.String         `tfsdk:"tier"`
-	Timeouts              timeouts.Value       `tfsdk:"timeouts"`
-	DeleteOnCreateTimeout types.Bool           `tfsdk:"delete_on_create_timeout"`
-	FailoverEnabled       types.Bool           `tfsdk:"failover_enabled"`
+	InstanceName          types.String          `tfsdk:"instance_name"`
+	WorkspaceName         types.String          `tfsdk:"workspace_name"`
+	Options               types.Object          `tfsdk:"options"`
+	Pipeline              customtypes.JSONValue `tfsdk:"pipeline"`
+	ProcessorID           types.String          `tfsdk:"id"`
+	ProcessorName         types.String          `tfsdk:"processor_name"`
+	ProjectID             types.String          `tfsdk:"project_id"`
+	State                 types.String          `tfsdk:"state"`
+	Stats                 types.String          `tfsdk:"stats"`
+	Tier                  types.String          `tfsdk:"tier"`
+	Timeouts              timeouts.Value        `tfsdk:"timeouts"`
+	DeleteOnCreateTimeout types.Bool            `tfsdk:"delete_on_create_timeout"`
+	FailoverEnabled       types.Bool            `tfsdk:"failover_enabled"`
+	RestartOnUpdate       types.Bool            `tfsdk:"restart_on_update"`
+	ResumeFromCheckpoint  types.Bool            `tfsdk:"resume_from_checkpoint"`
 }
 
 type TFOptionsModel struct {
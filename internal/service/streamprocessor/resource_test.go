@@ -53,6 +53,28 @@ func TestAccStreamProcessor_basic(t *testing.T) {
 	resource.Test(t, *basicTestCase(t))
 }
 
+func TestAccStreamProcessor_importWithIdentity(t *testing.T) {
+	var (
+		projectID, workspaceName = acc.ProjectIDExecutionWithStreamInstance(t)
+		randomSuffix             = acctest.RandString(5)
+		processorName            = "new-processor" + randomSuffix
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		TerraformVersionChecks:   acc.IdentityVersionChecks(),
+		CheckDestroy:             checkDestroyStreamProcessor,
+		Steps: []resource.TestStep{
+			{
+				Config: config(t, projectID, workspaceName, processorName, "", randomSuffix, sampleSrcConfig, testLogDestConfig, "", nil),
+				Check:  checkExists(resourceName),
+			},
+			acc.ImportStepWithIdentity(resourceName),
+		},
+	})
+}
+
 func TestAccStreamProcessor_withFailoverEnabled(t *testing.T) {
 	// Requires a real Atlas cluster: failover_enabled=true only accepts Atlas-to-Atlas or Atlas-to-Kafka pipelines.
 	var (
@@ -312,6 +334,44 @@ func TestAccStreamProcessor_EmptyStateUpdates(t *testing.T) {
 	}
 }
 
+func TestAccStreamProcessor_restartOnUpdate(t *testing.T) {
+	var (
+		projectID, workspaceName = acc.ProjectIDExecutionWithStreamInstance(t)
+		processorName            = "processor-restart-on-update"
+		initialPipeline          = `[{"$source":{"connectionName":"sample_stream_solar"}},{"$emit":{"connectionName":"__testLog"}}]`
+		updatedPipeline          = `[{"$source":{"connectionName":"sample_stream_solar"}},{"$match":{"group_id":1}},{"$emit":{"connectionName":"__testLog"}}]`
+		restartDisabledConfig    = `
+			state             = "STARTED"
+			restart_on_update = false`
+		restartEnabledConfig = `
+			state                  = "STARTED"
+			restart_on_update      = true
+			resume_from_checkpoint = false
+			timeouts = {
+				update = "10m"
+			}`
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroyStreamProcessor,
+		Steps: []resource.TestStep{
+			{
+				Config: configToUpdateStreamProcessor(projectID, workspaceName, processorName, restartDisabledConfig, initialPipeline),
+				Check:  checkAttributesFromBasicUpdateFlow(projectID, workspaceName, processorName, StartedState, initialPipeline),
+			},
+			{
+				Config:      configToUpdateStreamProcessor(projectID, workspaceName, processorName, restartDisabledConfig, updatedPipeline),
+				ExpectError: regexp.MustCompile("restart_on_update is false"),
+			},
+			{
+				Config: configToUpdateStreamProcessor(projectID, workspaceName, processorName, restartEnabledConfig, updatedPipeline),
+				Check:  checkAttributesFromBasicUpdateFlow(projectID, workspaceName, processorName, StartedState, updatedPipeline),
+			},
+		},
+	})
+}
+
 func TestAccStreamProcessor_InvalidStateTransitionUpdates(t *testing.T) {
 	transitions := map[string]struct {
 		setupState    string // Optional: Initial setup state (needed for STOPPED tests)
@@ -388,6 +448,10 @@ func TestAccStreamProcessor_createErrors(t *testing.T) {
 				Config:      config(t, projectID, workspaceName, processorName, streamprocessor.StoppedState, randomSuffix, invalidJSONConfig, testLogDestConfig, "", nil),
 				ExpectError: regexp.MustCompile("Invalid JSON String Value"),
 			},
+			{
+				Config:      configToUpdateStreamProcessor(projectID, workspaceName, processorName, "", `[{"$source":{"connectionName":"sample_stream_solar"}}]`),
+				ExpectError: regexp.MustCompile("the last stage of the pipeline must be \\$emit or \\$merge"),
+			},
 			{
 				Config:      config(t, projectID, workspaceName, processorName, streamprocessor.StoppedState, randomSuffix, sampleSrcConfig, testLogDestConfig, "", nil),
 				ExpectError: regexp.MustCompile("When creating a stream processor, the only valid states are CREATED and STARTED"),
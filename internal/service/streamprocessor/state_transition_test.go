@@ -9,9 +9,11 @@ import (
 	"go.mongodb.org/atlas-sdk/v20250312023/admin"
 	"go.mongodb.org/atlas-sdk/v20250312023/mockadmin"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen/customtypes"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprocessor"
 )
@@ -279,3 +281,67 @@ func TestValidateUpdateStateTransition(t *testing.T) {
 		})
 	}
 }
+
+func TestNeedsRestart(t *testing.T) {
+	pipeline := customtypes.NewJSONValue(`[{"$source":{"connectionName":"sample_stream_solar"}},{"$emit":{"connectionName":"__testLog"}}]`)
+	newPipeline := customtypes.NewJSONValue(`[{"$source":{"connectionName":"sample_stream_solar"}},{"$match":{"a":1}},{"$emit":{"connectionName":"__testLog"}}]`)
+	state := &streamprocessor.TFStreamProcessorRSModel{
+		Pipeline:        pipeline,
+		Options:         types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
+		FailoverEnabled: types.BoolNull(),
+		State:           types.StringValue(StartedState),
+		Tier:            types.StringValue("SP10"),
+	}
+	testCases := map[string]struct {
+		currentState string
+		plannedState string
+		plan         streamprocessor.TFStreamProcessorRSModel
+		expected     bool
+	}{
+		"no changes": {
+			plan:         *state,
+			currentState: StartedState,
+			plannedState: StartedState,
+		},
+		"pipeline changed": {
+			plan:         streamprocessor.TFStreamProcessorRSModel{Pipeline: newPipeline, Options: state.Options, Tier: state.Tier},
+			currentState: StartedState,
+			plannedState: StartedState,
+			expected:     true,
+		},
+		"tier changed": {
+			plan:         streamprocessor.TFStreamProcessorRSModel{Pipeline: pipeline, Options: state.Options, Tier: types.StringValue("SP30")},
+			currentState: StartedState,
+			plannedState: StartedState,
+			expected:     true,
+		},
+		"tier unknown": {
+			plan:         streamprocessor.TFStreamProcessorRSModel{Pipeline: pipeline, Options: state.Options, Tier: types.StringUnknown()},
+			currentState: StartedState,
+			plannedState: StartedState,
+		},
+		"failover enabled changed": {
+			plan:         streamprocessor.TFStreamProcessorRSModel{Pipeline: pipeline, Options: state.Options, Tier: state.Tier, FailoverEnabled: types.BoolValue(true)},
+			currentState: StartedState,
+			plannedState: StartedState,
+			expected:     true,
+		},
+		"pipeline changed and stopped": {
+			plan:         streamprocessor.TFStreamProcessorRSModel{Pipeline: newPipeline, Options: state.Options, Tier: state.Tier},
+			currentState: StartedState,
+			plannedState: StoppedState,
+		},
+		"pipeline changed not started": {
+			plan:         streamprocessor.TFStreamProcessorRSModel{Pipeline: newPipeline, Options: state.Options, Tier: state.Tier},
+			currentState: StoppedState,
+			plannedState: StartedState,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			currentState := *state
+			currentState.State = types.StringValue(tc.currentState)
+			assert.Equal(t, tc.expected, streamprocessor.NeedsRestartForTest(&tc.plan, &currentState, tc.plannedState))
+		})
+	}
+}
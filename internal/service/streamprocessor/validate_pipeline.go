@@ -0,0 +1,217 @@
+package streamprocessor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	stageSource         = "$source"
+	stageEmit           = "$emit"
+	stageMerge          = "$merge"
+	stageValidate       = "$validate"
+	stageTumblingWindow = "$tumblingWindow"
+	stageHoppingWindow  = "$hoppingWindow"
+	stageLookup         = "$lookup"
+)
+
+var (
+	windowUnits         = []string{"year", "month", "day", "hour", "minute", "second", "ms"}
+	validationActions   = []string{"discard", "dlq"}
+	windowInvalidStages = []string{stageSource, stageEmit, stageMerge, stageTumblingWindow, stageHoppingWindow}
+)
+
+type pipelineValidator struct {
+	errs []error
+	// connectionNames are the stream connections referenced by the pipeline, in order of appearance and without duplicates.
+	connectionNames []string
+}
+
+// validatePipeline returns the errors in the stages of a pipeline that Atlas would only report when the processor is created or started:
+// a pipeline must start with $source and end with $emit or $merge, and $source, $emit, $merge, $validate and window stages must have
+// their required fields. It also returns the stream connection names referenced by the pipeline.
+func validatePipeline(pipeline string) (connectionNames []string, err error) {
+	var stages []any
+	if err := json.Unmarshal([]byte(pipeline), &stages); err != nil {
+		return nil, fmt.Errorf("pipeline must be a JSON array of stages: %w", err)
+	}
+	if len(stages) == 0 {
+		return nil, errors.New("pipeline must contain at least a $source and an $emit or $merge stage")
+	}
+	v := &pipelineValidator{}
+	for i, stage := range stages {
+		name, body, ok := v.parseStage(fmt.Sprintf("stage %d", i), stage)
+		if !ok {
+			continue
+		}
+		attr := fmt.Sprintf("stage %d (%s)", i, name)
+		isFirst, isLast := i == 0, i == len(stages)-1
+		switch {
+		case name == stageSource && !isFirst:
+			v.errorf("%s: $source must be the first stage of the pipeline", attr)
+		case name != stageSource && isFirst:
+			v.errorf("%s: the first stage of the pipeline must be $source", attr)
+		case (name == stageEmit || name == stageMerge) && !isLast:
+			v.errorf("%s: %s must be the last stage of the pipeline", attr, name)
+		case name != stageEmit && name != stageMerge && isLast:
+			v.errorf("%s: the last stage of the pipeline must be $emit or $merge", attr)
+		}
+		v.validateStage(attr, name, body)
+	}
+	return v.connectionNames, errors.Join(v.errs...)
+}
+
+func (v *pipelineValidator) errorf(format string, args ...any) {
+	v.errs = append(v.errs, fmt.Errorf(format, args...))
+}
+
+// parseStage returns the name and body of a stage, which is an object with a single key starting with $.
+func (v *pipelineValidator) parseStage(attr string, stage any) (name string, body map[string]any, ok bool) {
+	obj, isObject := stage.(map[string]any)
+	if !isObject || len(obj) != 1 {
+		v.errorf("%s: must be an object with a single stage name", attr)
+		return "", nil, false
+	}
+	for key, value := range obj {
+		name = key
+		body, ok = value.(map[string]any)
+	}
+	if !strings.HasPrefix(name, "$") {
+		v.errorf("%s: %q is not a stage name, stage names start with $", attr, name)
+		return "", nil, false
+	}
+	if !ok && slices.Contains([]string{stageSource, stageEmit, stageMerge, stageValidate, stageTumblingWindow, stageHoppingWindow}, name) {
+		v.errorf("%s (%s): must be an object", attr, name)
+	}
+	return name, body, ok
+}
+
+func (v *pipelineValidator) validateStage(attr, name string, body map[string]any) {
+	switch name {
+	case stageSource:
+		_, hasDocuments := body["documents"]
+		if hasDocuments {
+			if _, ok := body["connectionName"]; ok {
+				v.errorf("%s: connectionName and documents can't be used together", attr)
+			}
+			return
+		}
+		v.requireConnectionName(attr, body)
+	case stageEmit:
+		v.requireConnectionName(attr, body)
+	case stageMerge:
+		into, ok := body["into"].(map[string]any)
+		if !ok {
+			v.errorf("%s: into is required and must be an object", attr)
+			return
+		}
+		attr += ".into"
+		v.requireConnectionName(attr, into)
+		for _, key := range []string{"db", "coll"} {
+			if _, ok := into[key]; !ok {
+				v.errorf("%s: %s is required", attr, key)
+			}
+		}
+	case stageValidate:
+		if _, ok := body["validator"].(map[string]any); !ok {
+			v.errorf("%s: validator is required and must be an object", attr)
+		}
+		if action, ok := body["validationAction"]; ok {
+			if s, _ := action.(string); !slices.Contains(validationActions, s) {
+				v.errorf("%s.validationAction: must be one of %s, got %v", attr, strings.Join(validationActions, ", "), action)
+			}
+		}
+	case stageTumblingWindow, stageHoppingWindow:
+		v.validateWindow(attr, name, body)
+	case stageLookup:
+		if from, ok := body["from"].(map[string]any); ok {
+			if connectionName, _ := from["connectionName"].(string); connectionName != "" {
+				v.addConnectionName(connectionName)
+			}
+		}
+	default:
+		// Other stages like $https or $externalFunction can also reference a connection.
+		if connectionName, _ := body["connectionName"].(string); connectionName != "" {
+			v.addConnectionName(connectionName)
+		}
+	}
+}
+
+func (v *pipelineValidator) requireConnectionName(attr string, body map[string]any) {
+	name, _ := body["connectionName"].(string)
+	if name == "" {
+		v.errorf("%s: connectionName is required", attr)
+		return
+	}
+	v.addConnectionName(name)
+}
+
+func (v *pipelineValidator) addConnectionName(name string) {
+	// Names starting with __ like __testLog are built-in sinks, not connections of the workspace.
+	if !strings.HasPrefix(name, "__") && !slices.Contains(v.connectionNames, name) {
+		v.connectionNames = append(v.connectionNames, name)
+	}
+}
+
+// validateWindow validates the interval and the inner pipeline of $tumblingWindow and $hoppingWindow, which can't contain
+// source, sink or other window stages.
+func (v *pipelineValidator) validateWindow(attr, name string, body map[string]any) {
+	v.validateInterval(attr+".interval", body["interval"])
+	if name == stageHoppingWindow {
+		v.validateInterval(attr+".hopSize", body["hopSize"])
+	}
+	stages, ok := body["pipeline"].([]any)
+	if !ok {
+		v.errorf("%s: pipeline is required and must be an array of stages", attr)
+		return
+	}
+	for i, stage := range stages {
+		stageAttr := fmt.Sprintf("%s.pipeline[%d]", attr, i)
+		innerName, innerBody, ok := v.parseStage(stageAttr, stage)
+		if !ok {
+			continue
+		}
+		if slices.Contains(windowInvalidStages, innerName) {
+			v.errorf("%s: %s is not allowed in the pipeline of %s", stageAttr, innerName, name)
+			continue
+		}
+		v.validateStage(fmt.Sprintf("%s (%s)", stageAttr, innerName), innerName, innerBody)
+	}
+}
+
+func (v *pipelineValidator) validateInterval(attr string, value any) {
+	interval, ok := value.(map[string]any)
+	if !ok {
+		v.errorf("%s: is required and must be an object with size and unit", attr)
+		return
+	}
+	if !positiveInteger(interval["size"]) {
+		v.errorf("%s.size: must be a positive integer, got %v", attr, interval["size"])
+	}
+	if unit, _ := interval["unit"].(string); !slices.Contains(windowUnits, unit) {
+		v.errorf("%s.unit: must be one of %s, got %v", attr, strings.Join(windowUnits, ", "), interval["unit"])
+	}
+}
+
+// positiveInteger returns true if the value is a positive integer in relaxed or canonical Extended JSON, e.g. 5 or {"$numberInt": "5"}.
+func positiveInteger(value any) bool {
+	switch value := value.(type) {
+	case float64:
+		return value >= 1 && value == float64(int64(value))
+	case map[string]any:
+		if len(value) != 1 {
+			return false
+		}
+		for _, key := range []string{"$numberInt", "$numberLong"} {
+			if s, ok := value[key].(string); ok {
+				n, err := strconv.ParseInt(s, 10, 64)
+				return err == nil && n >= 1
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package streamprocessor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprocessor"
+)
+
+func TestValidatePipeline(t *testing.T) {
+	testCases := map[string]struct {
+		pipeline        string
+		errContains     []string
+		connectionNames []string
+	}{
+		"source and emit": {
+			pipeline:        `[{"$source": {"connectionName": "sample_stream_solar"}}, {"$match": {"a": 1}}, {"$emit": {"connectionName": "kafka", "topic": "t"}}]`,
+			connectionNames: []string{"sample_stream_solar", "kafka"},
+		},
+		"built-in test log sink": {
+			pipeline:        `[{"$source": {"connectionName": "sample_stream_solar"}}, {"$emit": {"connectionName": "__testLog"}}]`,
+			connectionNames: []string{"sample_stream_solar"},
+		},
+		"merge with lookup and validate": {
+			pipeline: `[
+				{"$source": {"connectionName": "kafka", "topic": "in"}},
+				{"$lookup": {"from": {"connectionName": "cluster", "db": "db", "coll": "c"}, "localField": "a", "foreignField": "b", "as": "c"}},
+				{"$validate": {"validator": {"$jsonSchema": {}}, "validationAction": "dlq"}},
+				{"$merge": {"into": {"connectionName": "cluster", "db": "db", "coll": "out"}}}
+			]`,
+			connectionNames: []string{"kafka", "cluster"},
+		},
+		"source documents": {
+			pipeline:        `[{"$source": {"documents": [{"a": 1}]}}, {"$emit": {"connectionName": "kafka", "topic": "t"}}]`,
+			connectionNames: []string{"kafka"},
+		},
+		"windows with extended JSON sizes": {
+			pipeline: `[
+				{"$source": {"connectionName": "kafka"}},
+				{"$tumblingWindow": {"interval": {"size": {"$numberInt": "10"}, "unit": "second"}, "pipeline": [{"$group": {"_id": null}}]}},
+				{"$hoppingWindow": {"interval": {"size": 1, "unit": "minute"}, "hopSize": {"size": {"$numberLong": "30"}, "unit": "second"}, "pipeline": []}},
+				{"$emit": {"connectionName": "kafka", "topic": "t"}}
+			]`,
+			connectionNames: []string{"kafka"},
+		},
+		"not an array": {
+			pipeline:    `{"$source": {}}`,
+			errContains: []string{"pipeline must be a JSON array of stages"},
+		},
+		"empty": {
+			pipeline:    `[]`,
+			errContains: []string{"pipeline must contain at least"},
+		},
+		"source not first and missing sink": {
+			pipeline: `[{"$match": {}}, {"$source": {"connectionName": "kafka"}}]`,
+			errContains: []string{
+				"stage 0 ($match): the first stage of the pipeline must be $source",
+				"stage 1 ($source): $source must be the first stage of the pipeline",
+			},
+		},
+		"sink not last": {
+			pipeline: `[{"$source": {"connectionName": "kafka"}}, {"$emit": {"connectionName": "kafka"}}, {"$match": {}}]`,
+			errContains: []string{
+				"stage 1 ($emit): $emit must be the last stage of the pipeline",
+				"stage 2 ($match): the last stage of the pipeline must be $emit or $merge",
+			},
+		},
+		"invalid stages": {
+			pipeline: `[
+				{"$source": {"connectionName": "kafka", "documents": []}},
+				{"match": {}, "$project": {}},
+				{"$validate": {"validationAction": "error"}},
+				{"$merge": {"into": {"connectionName": "cluster"}}}
+			]`,
+			errContains: []string{
+				"stage 0 ($source): connectionName and documents can't be used together",
+				"stage 1: must be an object with a single stage name",
+				"stage 2 ($validate): validator is required and must be an object",
+				"stage 2 ($validate).validationAction: must be one of discard, dlq, got error",
+				"stage 3 ($merge).into: db is required",
+				"stage 3 ($merge).into: coll is required",
+			},
+		},
+		"missing connection names": {
+			pipeline: `[{"$source": {}}, {"$emit": {"topic": "t"}}]`,
+			errContains: []string{
+				"stage 0 ($source): connectionName is required",
+				"stage 1 ($emit): connectionName is required",
+			},
+		},
+		"invalid window": {
+			pipeline: `[
+				{"$source": {"connectionName": "kafka"}},
+				{"$tumblingWindow": {"interval": {"size": 0, "unit": "weeks"}, "pipeline": [{"$emit": {"connectionName": "kafka"}}]}},
+				{"$hoppingWindow": {"interval": {"size": 1.5, "unit": "second"}}},
+				{"$emit": {"connectionName": "kafka"}}
+			]`,
+			errContains: []string{
+				"stage 1 ($tumblingWindow).interval.size: must be a positive integer, got 0",
+				"stage 1 ($tumblingWindow).interval.unit: must be one of year, month, day, hour, minute, second, ms, got weeks",
+				"stage 1 ($tumblingWindow).pipeline[0]: $emit is not allowed in the pipeline of $tumblingWindow",
+				"stage 2 ($hoppingWindow).interval.size: must be a positive integer, got 1.5",
+				"stage 2 ($hoppingWindow).hopSize: is required and must be an object with size and unit",
+				"stage 2 ($hoppingWindow): pipeline is required and must be an array of stages",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			connectionNames, err := streamprocessor.ValidatePipelineForTest(tc.pipeline)
+			if len(tc.errContains) == 0 {
+				require.NoError(t, err)
+				assert.Equal(t, tc.connectionNames, connectionNames)
+				return
+			}
+			require.Error(t, err)
+			for _, errContains := range tc.errContains {
+				assert.Contains(t, err.Error(), errContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package streamprocessorstats
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const dataSourceName = "stream_processor_stats"
+
+var _ datasource.DataSource = &ds{}
+var _ datasource.DataSourceWithConfigure = &ds{}
+
+func DataSource() datasource.DataSource {
+	return &ds{
+		DSCommon: config.DSCommon{
+			DataSourceName: dataSourceName,
+		},
+	}
+}
+
+type ds struct {
+	config.DSCommon
+}
+
+func (d *ds) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DataSourceSchema()
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (d *ds) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfModel TFStreamProcessorStatsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := tfModel.ProjectID.ValueString()
+	workspaceName := tfModel.WorkspaceName.ValueString()
+	apiResp, _, err := d.Client.AtlasV2.StreamsAPI.GetStreamProcessor(ctx, projectID, workspaceName, tfModel.ProcessorName.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching resource", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFStreamProcessorStats(projectID, workspaceName, apiResp))...)
+}
@@ -0,0 +1,161 @@
+package streamprocessorstats
+
+import (
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func DataSourceSchema() schema.Schema {
+	attrs := map[string]schema.Attribute{
+		"project_id": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project, also known as `groupId` in the official documentation.",
+		},
+		"workspace_name": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Label that identifies the stream processing workspace.",
+		},
+	}
+	maps.Copy(attrs, processorStatsAttributes(true))
+	return schema.Schema{
+		MarkdownDescription: "Returns the runtime statistics of a stream processor as typed attributes.",
+		Attributes:          attrs,
+	}
+}
+
+func PluralDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Returns the runtime statistics of all the stream processors in a stream processing workspace as typed attributes.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project, also known as `groupId` in the official documentation.",
+			},
+			"workspace_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Label that identifies the stream processing workspace.",
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Statistics of each stream processor in the workspace.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: processorStatsAttributes(false),
+				},
+			},
+		},
+	}
+}
+
+// processorStatsAttributes returns the attributes with the statistics of a processor. processor_name is only required in the singular data source.
+func processorStatsAttributes(processorNameRequired bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"processor_name": schema.StringAttribute{
+			Required:            processorNameRequired,
+			Computed:            !processorNameRequired,
+			MarkdownDescription: "Label that identifies the stream processor.",
+		},
+		"processor_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Unique 24-hexadecimal character string that identifies the stream processor.",
+		},
+		"state": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The state of the stream processor. Commonly occurring states are 'CREATED', 'STARTED', 'STOPPED' and 'FAILED'.",
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Runtime status reported in the statistics of the stream processor, e.g. `running`. Empty if the processor has no statistics.",
+		},
+		"healthy": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Indicates whether the stream processor is `STARTED` and its statistics don't report an error.",
+		},
+		"last_error": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Last error reported by the stream processor. Empty if no error is reported.",
+		},
+		"input_message_count": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Number of messages read by the stream processor.",
+		},
+		"input_message_size": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Size in bytes of the messages read by the stream processor.",
+		},
+		"output_message_count": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Number of messages written by the stream processor.",
+		},
+		"output_message_size": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Size in bytes of the messages written by the stream processor.",
+		},
+		"dlq_message_count": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Number of messages written to the dead letter queue.",
+		},
+		"dlq_message_size": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Size in bytes of the messages written to the dead letter queue.",
+		},
+		"state_size": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Size in bytes of the state of the stream processor, e.g. open windows.",
+		},
+		"memory_usage_bytes": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Memory in bytes used by the stream processor.",
+		},
+		"change_stream_lag_seconds": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Seconds between the last event read from a change stream source and the latest event of the change stream. Null if the source is not a change stream.",
+		},
+		"kafka_offset_lag": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Total number of messages of all the partitions of a Kafka source that are not read yet. Null if the source is not Kafka.",
+		},
+		"last_message_in": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Timestamp of the last message read by the stream processor.",
+		},
+		"watermark": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Timestamp of the watermark of the stream processor, which indicates up to which event time windows are complete.",
+		},
+	}
+}
+
+type TFStreamProcessorStatsModel struct {
+	ProjectID     types.String `tfsdk:"project_id"`
+	WorkspaceName types.String `tfsdk:"workspace_name"`
+	TFProcessorStatsModel
+}
+
+type TFStreamProcessorsStatsModel struct {
+	ProjectID     types.String            `tfsdk:"project_id"`
+	WorkspaceName types.String            `tfsdk:"workspace_name"`
+	Results       []TFProcessorStatsModel `tfsdk:"results"`
+}
+
+type TFProcessorStatsModel struct {
+	InputMessageSize       types.Float64 `tfsdk:"input_message_size"`
+	OutputMessageSize      types.Float64 `tfsdk:"output_message_size"`
+	DLQMessageSize         types.Float64 `tfsdk:"dlq_message_size"`
+	StateSize              types.Float64 `tfsdk:"state_size"`
+	MemoryUsageBytes       types.Float64 `tfsdk:"memory_usage_bytes"`
+	ProcessorName          types.String  `tfsdk:"processor_name"`
+	ProcessorID            types.String  `tfsdk:"processor_id"`
+	State                  types.String  `tfsdk:"state"`
+	Status                 types.String  `tfsdk:"status"`
+	LastError              types.String  `tfsdk:"last_error"`
+	LastMessageIn          types.String  `tfsdk:"last_message_in"`
+	Watermark              types.String  `tfsdk:"watermark"`
+	InputMessageCount      types.Int64   `tfsdk:"input_message_count"`
+	OutputMessageCount     types.Int64   `tfsdk:"output_message_count"`
+	DLQMessageCount        types.Int64   `tfsdk:"dlq_message_count"`
+	ChangeStreamLagSeconds types.Int64   `tfsdk:"change_stream_lag_seconds"`
+	KafkaOffsetLag         types.Int64   `tfsdk:"kafka_offset_lag"`
+	Healthy                types.Bool    `tfsdk:"healthy"`
+}
@@ -0,0 +1,62 @@
+package streamprocessorstats_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+func TestAccStreamProcessorStatsDS_basic(t *testing.T) {
+	var (
+		projectID, workspaceName = acc.ProjectIDExecutionWithStreamInstance(t)
+		processorName            = "processor-stats"
+		dataSourceName           = "data.mongodbatlas_stream_processor_stats.test"
+		pluralDataSourceName     = "data.mongodbatlas_stream_processors_stats.test"
+	)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic(projectID, workspaceName, processorName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "processor_name", processorName),
+					resource.TestCheckResourceAttr(dataSourceName, "state", "STARTED"),
+					resource.TestCheckResourceAttr(dataSourceName, "healthy", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "last_error", ""),
+					resource.TestCheckResourceAttrSet(dataSourceName, "processor_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "input_message_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "output_message_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "dlq_message_count"),
+					resource.TestCheckResourceAttrSet(pluralDataSourceName, "results.#"),
+				),
+			},
+		},
+	})
+}
+
+func configBasic(projectID, workspaceName, processorName string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_stream_processor" "test" {
+			project_id     = %[1]q
+			workspace_name = %[2]q
+			processor_name = %[3]q
+			pipeline       = "[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"
+			state          = "STARTED"
+		}
+
+		data "mongodbatlas_stream_processor_stats" "test" {
+			project_id     = mongodbatlas_stream_processor.test.project_id
+			workspace_name = mongodbatlas_stream_processor.test.workspace_name
+			processor_name = mongodbatlas_stream_processor.test.processor_name
+		}
+
+		data "mongodbatlas_stream_processors_stats" "test" {
+			project_id     = mongodbatlas_stream_processor.test.project_id
+			workspace_name = mongodbatlas_stream_processor.test.workspace_name
+		}
+	`, projectID, workspaceName, processorName)
+}
@@ -0,0 +1,121 @@
+package streamprocessorstats
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprocessor"
+)
+
+func NewTFStreamProcessorStats(projectID, workspaceName string, apiResp *admin.StreamsProcessorWithStats) *TFStreamProcessorStatsModel {
+	return &TFStreamProcessorStatsModel{
+		ProjectID:             types.StringValue(projectID),
+		WorkspaceName:         types.StringValue(workspaceName),
+		TFProcessorStatsModel: NewTFProcessorStats(apiResp),
+	}
+}
+
+func NewTFStreamProcessorsStats(projectID, workspaceName string, apiResp []admin.StreamsProcessorWithStats) *TFStreamProcessorsStatsModel {
+	results := make([]TFProcessorStatsModel, len(apiResp))
+	for i := range apiResp {
+		results[i] = NewTFProcessorStats(&apiResp[i])
+	}
+	return &TFStreamProcessorsStatsModel{
+		ProjectID:     types.StringValue(projectID),
+		WorkspaceName: types.StringValue(workspaceName),
+		Results:       results,
+	}
+}
+
+// NewTFProcessorStats returns the typed statistics of a processor from its stats document. Statistics that are not in the document are null,
+// e.g. processors that are not started have no statistics.
+func NewTFProcessorStats(apiResp *admin.StreamsProcessorWithStats) TFProcessorStatsModel {
+	stats, _ := apiResp.GetStats().(map[string]any)
+	lastError := lastError(stats)
+	return TFProcessorStatsModel{
+		ProcessorName:          types.StringValue(apiResp.GetName()),
+		ProcessorID:            types.StringValue(apiResp.GetId()),
+		State:                  types.StringValue(apiResp.GetState()),
+		Status:                 types.StringValue(stringStat(stats, "status")),
+		LastError:              types.StringValue(lastError),
+		LastMessageIn:          types.StringValue(timestampStat(stats, "lastMessageIn")),
+		Watermark:              types.StringValue(timestampStat(stats, "watermark")),
+		InputMessageCount:      int64Stat(stats, "inputMessageCount"),
+		InputMessageSize:       float64Stat(stats, "inputMessageSize"),
+		OutputMessageCount:     int64Stat(stats, "outputMessageCount"),
+		OutputMessageSize:      float64Stat(stats, "outputMessageSize"),
+		DLQMessageCount:        int64Stat(stats, "dlqMessageCount"),
+		DLQMessageSize:         float64Stat(stats, "dlqMessageSize"),
+		StateSize:              float64Stat(stats, "stateSize"),
+		MemoryUsageBytes:       float64Stat(stats, "memoryTrackerBytes"),
+		ChangeStreamLagSeconds: int64Stat(stats, "changeStreamTimeDifferenceSecs"),
+		KafkaOffsetLag:         kafkaOffsetLag(stats),
+		Healthy:                types.BoolValue(apiResp.GetState() == streamprocessor.StartedState && lastError == ""),
+	}
+}
+
+// lastError returns the error of the stats document, which is a command response with ok set to 0 and errmsg when the processor fails.
+func lastError(stats map[string]any) string {
+	for _, key := range []string{"errmsg", "errorMsg"} {
+		if msg := stringStat(stats, key); msg != "" {
+			return msg
+		}
+	}
+	if ok, isNumber := stats["ok"].(float64); isNumber && ok == 0 {
+		return "stream processor statistics report ok: 0"
+	}
+	return ""
+}
+
+func stringStat(stats map[string]any, key string) string {
+	s, _ := stats[key].(string)
+	return s
+}
+
+func int64Stat(stats map[string]any, key string) types.Int64 {
+	if n, ok := stats[key].(float64); ok {
+		return types.Int64Value(int64(n))
+	}
+	return types.Int64Null()
+}
+
+func float64Stat(stats map[string]any, key string) types.Float64 {
+	if n, ok := stats[key].(float64); ok {
+		return types.Float64Value(n)
+	}
+	return types.Float64Null()
+}
+
+// timestampStat returns a timestamp in RFC 3339 format, which can be a string, milliseconds since the Unix epoch or an Extended JSON $date.
+func timestampStat(stats map[string]any, key string) string {
+	value := stats[key]
+	if date, ok := value.(map[string]any); ok {
+		value = date["$date"]
+	}
+	switch value := value.(type) {
+	case string:
+		return value
+	case float64:
+		return time.UnixMilli(int64(value)).UTC().Format(time.RFC3339Nano)
+	}
+	return ""
+}
+
+// kafkaOffsetLag returns the sum of the offset lag of all the partitions of a Kafka source.
+func kafkaOffsetLag(stats map[string]any) types.Int64 {
+	partitions, ok := stats["kafkaPartitions"].([]any)
+	if !ok {
+		return types.Int64Null()
+	}
+	var lag int64
+	for _, partition := range partitions {
+		if p, ok := partition.(map[string]any); ok {
+			if n, ok := p["partitionOffsetLag"].(float64); ok {
+				lag += int64(n)
+			}
+		}
+	}
+	return types.Int64Value(lag)
+}
@@ -0,0 +1,154 @@
+package streamprocessorstats_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprocessor"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprocessorstats"
+)
+
+const (
+	projectID     = "661fe3ad234b02027dabcabc"
+	workspaceName = "test-workspace-name"
+	processorName = "processor1"
+	processorID   = "66b39806187592e8d721215d"
+)
+
+func processorWithStats(t *testing.T, state, stats string) *admin.StreamsProcessorWithStats {
+	t.Helper()
+	processor := admin.NewStreamsProcessorWithStats(processorID, processorName, []any{}, state)
+	if stats != "" {
+		var statsDoc any
+		require.NoError(t, json.Unmarshal([]byte(stats), &statsDoc))
+		processor.SetStats(statsDoc)
+	}
+	return processor
+}
+
+func TestNewTFProcessorStats(t *testing.T) {
+	testCases := map[string]struct {
+		stats    string
+		state    string
+		expected streamprocessorstats.TFProcessorStatsModel
+	}{
+		"change stream source": {
+			state: streamprocessor.StartedState,
+			stats: `{
+				"dlqMessageCount": 2, "dlqMessageSize": 100.0, "inputMessageCount": 12, "inputMessageSize": 4681.0,
+				"outputMessageCount": 10, "outputMessageSize": 4581.0, "memoryTrackerBytes": 1024.0, "stateSize": 0.0,
+				"changeStreamTimeDifferenceSecs": 3, "lastMessageIn": {"$date": "2024-08-07T16:30:00Z"},
+				"watermark": 1723048200000, "ok": 1.0, "status": "running"
+			}`,
+			expected: streamprocessorstats.TFProcessorStatsModel{
+				Status:                 types.StringValue("running"),
+				LastError:              types.StringValue(""),
+				LastMessageIn:          types.StringValue("2024-08-07T16:30:00Z"),
+				Watermark:              types.StringValue("2024-08-07T16:30:00Z"),
+				InputMessageCount:      types.Int64Value(12),
+				InputMessageSize:       types.Float64Value(4681),
+				OutputMessageCount:     types.Int64Value(10),
+				OutputMessageSize:      types.Float64Value(4581),
+				DLQMessageCount:        types.Int64Value(2),
+				DLQMessageSize:         types.Float64Value(100),
+				StateSize:              types.Float64Value(0),
+				MemoryUsageBytes:       types.Float64Value(1024),
+				ChangeStreamLagSeconds: types.Int64Value(3),
+				KafkaOffsetLag:         types.Int64Null(),
+				Healthy:                types.BoolValue(true),
+			},
+		},
+		"kafka source": {
+			state: streamprocessor.StartedState,
+			stats: `{
+				"inputMessageCount": 5, "outputMessageCount": 5, "ok": 1.0, "status": "running",
+				"kafkaPartitions": [{"partition": 0, "partitionOffsetLag": 7}, {"partition": 1, "partitionOffsetLag": 3}]
+			}`,
+			expected: streamprocessorstats.TFProcessorStatsModel{
+				Status:                 types.StringValue("running"),
+				LastError:              types.StringValue(""),
+				LastMessageIn:          types.StringValue(""),
+				Watermark:              types.StringValue(""),
+				InputMessageCount:      types.Int64Value(5),
+				InputMessageSize:       types.Float64Null(),
+				OutputMessageCount:     types.Int64Value(5),
+				OutputMessageSize:      types.Float64Null(),
+				DLQMessageCount:        types.Int64Null(),
+				DLQMessageSize:         types.Float64Null(),
+				StateSize:              types.Float64Null(),
+				MemoryUsageBytes:       types.Float64Null(),
+				ChangeStreamLagSeconds: types.Int64Null(),
+				KafkaOffsetLag:         types.Int64Value(10),
+				Healthy:                types.BoolValue(true),
+			},
+		},
+		"failed": {
+			state: streamprocessor.FailedState,
+			stats: `{"ok": 0.0, "errmsg": "connection refused", "status": "error"}`,
+			expected: streamprocessorstats.TFProcessorStatsModel{
+				Status:                 types.StringValue("error"),
+				LastError:              types.StringValue("connection refused"),
+				LastMessageIn:          types.StringValue(""),
+				Watermark:              types.StringValue(""),
+				InputMessageCount:      types.Int64Null(),
+				InputMessageSize:       types.Float64Null(),
+				OutputMessageCount:     types.Int64Null(),
+				OutputMessageSize:      types.Float64Null(),
+				DLQMessageCount:        types.Int64Null(),
+				DLQMessageSize:         types.Float64Null(),
+				StateSize:              types.Float64Null(),
+				MemoryUsageBytes:       types.Float64Null(),
+				ChangeStreamLagSeconds: types.Int64Null(),
+				KafkaOffsetLag:         types.Int64Null(),
+				Healthy:                types.BoolValue(false),
+			},
+		},
+		"created without stats": {
+			state: streamprocessor.CreatedState,
+			expected: streamprocessorstats.TFProcessorStatsModel{
+				Status:                 types.StringValue(""),
+				LastError:              types.StringValue(""),
+				LastMessageIn:          types.StringValue(""),
+				Watermark:              types.StringValue(""),
+				InputMessageCount:      types.Int64Null(),
+				InputMessageSize:       types.Float64Null(),
+				OutputMessageCount:     types.Int64Null(),
+				OutputMessageSize:      types.Float64Null(),
+				DLQMessageCount:        types.Int64Null(),
+				DLQMessageSize:         types.Float64Null(),
+				StateSize:              types.Float64Null(),
+				MemoryUsageBytes:       types.Float64Null(),
+				ChangeStreamLagSeconds: types.Int64Null(),
+				KafkaOffsetLag:         types.Int64Null(),
+				Healthy:                types.BoolValue(false),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.expected.ProcessorName = types.StringValue(processorName)
+			tc.expected.ProcessorID = types.StringValue(processorID)
+			tc.expected.State = types.StringValue(tc.state)
+			assert.Equal(t, tc.expected, streamprocessorstats.NewTFProcessorStats(processorWithStats(t, tc.state, tc.stats)))
+		})
+	}
+}
+
+func TestNewTFStreamProcessorsStats(t *testing.T) {
+	processors := []admin.StreamsProcessorWithStats{
+		*processorWithStats(t, streamprocessor.StartedState, `{"inputMessageCount": 1, "ok": 1.0}`),
+		*processorWithStats(t, streamprocessor.StoppedState, ""),
+	}
+	result := streamprocessorstats.NewTFStreamProcessorsStats(projectID, workspaceName, processors)
+	assert.Equal(t, types.StringValue(projectID), result.ProjectID)
+	assert.Equal(t, types.StringValue(workspaceName), result.WorkspaceName)
+	require.Len(t, result.Results, 2)
+	assert.Equal(t, types.Int64Value(1), result.Results[0].InputMessageCount)
+	assert.True(t, result.Results[0].Healthy.ValueBool())
+	assert.False(t, result.Results[1].Healthy.ValueBool())
+}
@@ -0,0 +1,165 @@
+package x509authenticationdatabaseuser
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spf13/cast"
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	ephemeralResourceName          = "x509_authentication_database_user"
+	defaultMonthsUntilExpiration   = 3
+	errorX509CertificateWithoutKey = "the certificate returned by Atlas must have a certificate and a private key"
+)
+
+var _ ephemeral.EphemeralResource = &ephemeralRS{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralRS{}
+
+type ephemeralRS struct {
+	config.ESCommon
+}
+
+// EphemeralResource returns an ephemeral resource that creates an Atlas-managed X.509 certificate for a database user.
+// Unlike the mongodbatlas_x509_authentication_database_user resource, the certificate and its private key are never stored in state.
+func EphemeralResource() ephemeral.EphemeralResource {
+	return &ephemeralRS{
+		ESCommon: config.ESCommon{
+			ResourceName: ephemeralResourceName,
+		},
+	}
+}
+
+type TFEphemeralModel struct {
+	ProjectID             types.String `tfsdk:"project_id"`
+	Username              types.String `tfsdk:"username"`
+	Certificate           types.String `tfsdk:"certificate"`
+	PrivateKey            types.String `tfsdk:"private_key"`
+	SerialNumber          types.String `tfsdk:"serial_number"`
+	NotAfter              types.String `tfsdk:"not_after"`
+	MonthsUntilExpiration types.Int64  `tfsdk:"months_until_expiration"`
+}
+
+func (r *ephemeralRS) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Username of the database user to create a certificate for. The user must have `x509_type` set to `MANAGED`.",
+			},
+			"months_until_expiration": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 24),
+				},
+				MarkdownDescription: fmt.Sprintf("Number of months that the certificate is valid for, between 1 and 24. Defaults to `%d`.", defaultMonthsUntilExpiration),
+			},
+			"certificate": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "PEM-encoded X.509 certificate.",
+			},
+			"private_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "PEM-encoded private key of the certificate.",
+			},
+			"serial_number": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Serial number of the certificate.",
+			},
+			"not_after": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Expiration date of the certificate, in RFC3339 format.",
+			},
+		},
+	}
+}
+
+func (r *ephemeralRS) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model TFEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.EphemeralResourceData == nil || r.EphemeralResourceData.Client == nil {
+		resp.Diagnostics.AddError("Provider not configured", "the provider must be configured to create an X.509 certificate")
+		return
+	}
+
+	projectID := model.ProjectID.ValueString()
+	username := model.Username.ValueString()
+	months := defaultMonthsUntilExpiration
+	if !model.MonthsUntilExpiration.IsNull() {
+		months = int(model.MonthsUntilExpiration.ValueInt64())
+	}
+	params := &admin.UserCert{
+		MonthsUntilExpiration: &months,
+	}
+	connV2 := r.EphemeralResourceData.Client.AtlasV2
+	certStr, _, err := connV2.X509AuthenticationAPI.CreateDatabaseUserCert(ctx, projectID, username, params).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating X.509 certificate", fmt.Sprintf(errorX509AuthDBUsersCreate, username, projectID, err))
+		return
+	}
+
+	certificate, privateKey, cert, err := splitCertificatePEM(cast.ToString(certStr))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading X.509 certificate", err.Error())
+		return
+	}
+	model.MonthsUntilExpiration = types.Int64Value(int64(months))
+	model.Certificate = types.StringValue(certificate)
+	model.PrivateKey = types.StringValue(privateKey)
+	model.SerialNumber = types.StringValue(cert.SerialNumber.String())
+	model.NotAfter = types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
+
+// splitCertificatePEM splits the PEM returned by Atlas, which has both the certificate and its private key, and parses the certificate.
+func splitCertificatePEM(certPEM string) (certificate, privateKey string, cert *x509.Certificate, err error) {
+	var certBuf, keyBuf bytes.Buffer
+	rest := []byte(certPEM)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		switch {
+		case block.Type == "CERTIFICATE":
+			if cert == nil {
+				if cert, err = x509.ParseCertificate(block.Bytes); err != nil {
+					return "", "", nil, err
+				}
+			}
+			err = pem.Encode(&certBuf, block)
+		case strings.HasSuffix(block.Type, "PRIVATE KEY"):
+			err = pem.Encode(&keyBuf, block)
+		}
+		if err != nil {
+			return "", "", nil, err
+		}
+	}
+	if cert == nil || keyBuf.Len() == 0 {
+		return "", "", nil, errors.New(errorX509CertificateWithoutKey)
+	}
+	return certBuf.String(), keyBuf.String(), cert, nil
+}
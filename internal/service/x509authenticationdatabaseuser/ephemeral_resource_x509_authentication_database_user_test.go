@@ -0,0 +1,138 @@
+package x509authenticationdatabaseuser_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/x509authenticationdatabaseuser"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const echoResourceName = "echo.certificate"
+
+func TestAccGenericX509AuthDBUser_ephemeral(t *testing.T) {
+	var (
+		projectID = acc.ProjectIDExecution(t)
+		username  = acc.RandomName()
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_10_0)},
+		ProtoV6ProviderFactories: acc.ProtoV6FactoriesWithEcho(),
+		Steps: []resource.TestStep{
+			{
+				// The database user must exist before the ephemeral resource is opened during plan.
+				Config: configEphemeralDatabaseUser(projectID, username),
+			},
+			{
+				Config: configEphemeralDatabaseUser(projectID, username) + configEphemeral(2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, tfjsonpath.New("data").AtMapKey("months_until_expiration"), knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue(echoResourceName, tfjsonpath.New("data").AtMapKey("certificate"), knownvalue.StringRegexp(regexp.MustCompile(`^-----BEGIN CERTIFICATE-----`))),
+					statecheck.ExpectKnownValue(echoResourceName, tfjsonpath.New("data").AtMapKey("private_key"), knownvalue.StringRegexp(regexp.MustCompile(`PRIVATE KEY-----`))),
+					statecheck.ExpectKnownValue(echoResourceName, tfjsonpath.New("data").AtMapKey("serial_number"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, tfjsonpath.New("data").AtMapKey("not_after"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestSplitCertificatePEM(t *testing.T) {
+	certPEM, keyPEM := generateCertificate(t)
+	testCases := map[string]struct {
+		input         string
+		expectedError bool
+	}{
+		"certificate and key":    {input: certPEM + keyPEM},
+		"key before certificate": {input: keyPEM + certPEM},
+		"certificate only":       {input: certPEM, expectedError: true},
+		"key only":               {input: keyPEM, expectedError: true},
+		"not a PEM":              {input: "not a certificate", expectedError: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			certificate, privateKey, cert, err := x509authenticationdatabaseuser.SplitCertificatePEMForTest(tc.input)
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, certPEM, certificate)
+			assert.Equal(t, keyPEM, privateKey)
+			assert.Equal(t, "42", cert.SerialNumber.String())
+			assert.Equal(t, "test-user", cert.Subject.CommonName)
+		})
+	}
+}
+
+func generateCertificate(t *testing.T) (certPEM, keyPEM string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{CommonName: "test-user"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().AddDate(0, 3, 0),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	var certBuf, keyBuf strings.Builder
+	require.NoError(t, pem.Encode(&certBuf, &pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	require.NoError(t, pem.Encode(&keyBuf, &pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+	return certBuf.String(), keyBuf.String()
+}
+
+func configEphemeralDatabaseUser(projectID, username string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_database_user" "user" {
+			project_id         = %[1]q
+			username           = %[2]q
+			x509_type          = "MANAGED"
+			auth_database_name = "$external"
+
+			roles {
+				role_name     = "atlasAdmin"
+				database_name = "admin"
+			}
+		}
+	`, projectID, username)
+}
+
+func configEphemeral(months int) string {
+	return fmt.Sprintf(`
+		ephemeral "mongodbatlas_x509_authentication_database_user" "test" {
+			project_id              = mongodbatlas_database_user.user.project_id
+			username                = mongodbatlas_database_user.user.username
+			months_until_expiration = %[1]d
+		}
+
+		provider "echo" {
+			data = ephemeral.mongodbatlas_x509_authentication_database_user.test
+		}
+
+		resource "echo" "certificate" {}
+	`, months)
+}
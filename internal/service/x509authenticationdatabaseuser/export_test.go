@@ -0,0 +1,3 @@
+package x509authenticationdatabaseuser
+
+var SplitCertificatePEMForTest = splitCertificatePEM
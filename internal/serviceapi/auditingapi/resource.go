@@ -14,6 +14,7 @@ import (
 
 var _ resource.ResourceWithConfigure = &rs{}
 var _ resource.ResourceWithImportState = &rs{}
+var _ resource.ResourceWithIdentity = &rs{}
 
 const apiVersionHeader = "application/vnd.atlas.2023-01-01+json"
 
@@ -122,6 +123,11 @@ func (r *rs) ImportState(ctx context.Context, req resource.ImportStateRequest, r
 	autogen.HandleImport(ctx, idAttributes, req, resp, r)
 }
 
+func (r *rs) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	idAttributes := []string{"group_id"}
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(ResourceSchema(ctx), idAttributes...)
+}
+
 func readAPICallParams(model any) *config.APICallParams {
 	m := model.(*TFModel)
 	pathParams := map[string]string{
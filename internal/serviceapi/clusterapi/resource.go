@@ -15,6 +15,7 @@ import (
 
 var _ resource.ResourceWithConfigure = &rs{}
 var _ resource.ResourceWithImportState = &rs{}
+var _ resource.ResourceWithIdentity = &rs{}
 var _ resource.ResourceWithMoveState = &rs{}
 
 const apiVersionHeader = "application/vnd.atlas.2024-08-05+json"
@@ -171,6 +172,11 @@ func (r *rs) ImportState(ctx context.Context, req resource.ImportStateRequest, r
 	autogen.HandleImport(ctx, idAttributes, req, resp, r)
 }
 
+func (r *rs) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	idAttributes := []string{"group_id", "name"}
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(ResourceSchema(ctx), idAttributes...)
+}
+
 func readAPICallParams(model any) *config.APICallParams {
 	m := model.(*TFModel)
 	pathParams := map[string]string{
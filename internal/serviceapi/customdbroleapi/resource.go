@@ -4,16 +4,19 @@ package customdbroleapi
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/retrystrategy"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 )
 
 var _ resource.ResourceWithConfigure = &rs{}
 var _ resource.ResourceWithImportState = &rs{}
+var _ resource.ResourceWithIdentity = &rs{}
 
 const apiVersionHeader = "application/vnd.atlas.2023-01-01+json"
 
@@ -53,11 +56,12 @@ func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resou
 		Method:        "POST",
 	}
 	reqHandle := autogen.HandleCreateReq{
-		Hooks:      r,
-		Resp:       resp,
-		Client:     r.Client,
-		Plan:       &plan,
-		CallParams: &callParams,
+		Hooks:         r,
+		Resp:          resp,
+		Client:        r.Client,
+		Plan:          &plan,
+		CallParams:    &callParams,
+		OperationLock: "project:{groupId}:custom_db_roles",
 	}
 	autogen.HandleCreate(ctx, reqHandle)
 }
@@ -99,11 +103,22 @@ func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resou
 		Method:        "PATCH",
 	}
 	reqHandle := autogen.HandleUpdateReq{
-		Hooks:      r,
-		Resp:       resp,
-		Client:     r.Client,
-		Plan:       &plan,
-		CallParams: &callParams,
+		Hooks:         r,
+		Resp:          resp,
+		Client:        r.Client,
+		Plan:          &plan,
+		CallParams:    &callParams,
+		OperationLock: "project:{groupId}:custom_db_roles",
+		Retry: &autogen.RetryReq{
+			ErrorCodes:         []string{},
+			StatusCodes:        []int{500},
+			MaxAttempts:        5,
+			AllowNonIdempotent: true,
+			TimeConfig: retrystrategy.TimeConfig{
+				Timeout:    300 * time.Second,
+				MinTimeout: 10 * time.Second,
+			},
+		},
 	}
 	autogen.HandleUpdate(ctx, reqHandle)
 }
@@ -123,6 +138,11 @@ func (r *rs) ImportState(ctx context.Context, req resource.ImportStateRequest, r
 	autogen.HandleImport(ctx, idAttributes, req, resp, r)
 }
 
+func (r *rs) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	idAttributes := []string{"group_id", "role_name"}
+	resp.IdentitySchema = conversion.IdentitySchemaFromResource(ResourceSchema(ctx), idAttributes...)
+}
+
 func readAPICallParams(model any) *config.APICallParams {
 	m := model.(*TFModel)
 	pathParams := map[string]string{
@@ -153,5 +173,15 @@ func deleteRequest(r *rs, client *config.MongoDBClient, model *TFModel, diags *d
 			PathParams:    pathParams,
 			Method:        "DELETE",
 		},
+		OperationLock: "project:{groupId}:custom_db_roles",
+		Retry: &autogen.RetryReq{
+			ErrorCodes:  []string{},
+			StatusCodes: []int{500},
+			MaxAttempts: 5,
+			TimeConfig: retrystrategy.TimeConfig{
+				Timeout:    300 * time.Second,
+				MinTimeout: 10 * time.Second,
+			},
+		},
 	}
 }
@@ -5,7 +5,6 @@ package searchindexapi
 import (
 	"context"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -51,8 +50,8 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 								"char_filters": schema.ListAttribute{
 									Optional:            true,
 									MarkdownDescription: "Filters that examine text one character at a time and perform filtering operations.",
-									CustomType:          customtypes.NewListType[jsontypes.Normalized](ctx),
-									ElementType:         jsontypes.NormalizedType{},
+									CustomType:          customtypes.NewListType[customtypes.JSONValue](ctx),
+									ElementType:         customtypes.JSONType{},
 								},
 								"name": schema.StringAttribute{
 									Required:            true,
@@ -61,14 +60,14 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 								"token_filters": schema.ListAttribute{
 									Optional:            true,
 									MarkdownDescription: "Filter that performs operations such as:\n\n- Stemming, which reduces related words, such as \"talking\", \"talked\", and \"talks\" to their root word \"talk\".\n\n- Redaction, which is the removal of sensitive information from public documents.",
-									CustomType:          customtypes.NewListType[jsontypes.Normalized](ctx),
-									ElementType:         jsontypes.NormalizedType{},
+									CustomType:          customtypes.NewListType[customtypes.JSONValue](ctx),
+									ElementType:         customtypes.JSONType{},
 								},
 								"tokenizer": schema.MapAttribute{
 									Required:            true,
 									MarkdownDescription: "Tokenizer that you want to use to create tokens. Tokens determine how Atlas Search splits up text into discrete chunks for indexing.",
-									CustomType:          customtypes.NewMapType[jsontypes.Normalized](ctx),
-									ElementType:         jsontypes.NormalizedType{},
+									CustomType:          customtypes.NewMapType[customtypes.JSONValue](ctx),
+									ElementType:         customtypes.JSONType{},
 								},
 							},
 						},
@@ -76,8 +75,8 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 					"fields": schema.ListAttribute{
 						Optional:            true,
 						MarkdownDescription: "Settings that configure the fields, one per object, to index. You must define at least one \"vector\" type field. You can optionally define \"filter\" type fields also.",
-						CustomType:          customtypes.NewListType[jsontypes.Normalized](ctx),
-						ElementType:         jsontypes.NormalizedType{},
+						CustomType:          customtypes.NewListType[customtypes.JSONValue](ctx),
+						ElementType:         customtypes.JSONType{},
 					},
 					"mappings": schema.SingleNestedAttribute{
 						Optional:            true,
@@ -87,13 +86,13 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 							"dynamic": schema.StringAttribute{
 								Optional:            true,
 								MarkdownDescription: "Indicates whether the index uses static, default dynamic, or configurable dynamic mappings. Set to `true` to enable dynamic mapping with default type set or define object to specify the name of the configured type sets for dynamic mapping. If you specify configurable dynamic mappings, you must define the referred type sets in the `typeSets` field. Set to `false` to use only static mappings through `mappings.fields`.",
-								CustomType:          jsontypes.NormalizedType{},
+								CustomType:          customtypes.JSONType{},
 							},
 							"fields": schema.MapAttribute{
 								Optional:            true,
 								MarkdownDescription: "One or more field specifications for the Atlas Search index. Required if `mappings.dynamic` is omitted or set to `false`.",
-								CustomType:          customtypes.NewMapType[jsontypes.Normalized](ctx),
-								ElementType:         jsontypes.NormalizedType{},
+								CustomType:          customtypes.NewMapType[customtypes.JSONValue](ctx),
+								ElementType:         customtypes.JSONType{},
 							},
 						},
 					},
@@ -114,12 +113,12 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 					"sort": schema.StringAttribute{
 						Optional:            true,
 						MarkdownDescription: "Sort definition for the index. When defined, the index will be pre-sorted on the specified fields, which improves query sort performance for those fields. Supports two formats: simple format with field name and direction, or complex format with additional options. The `order` field is required (1=ascending, -1=descending).The `noData` field is optional and controls how missing values are sorted(default: \"lowest\").",
-						CustomType:          jsontypes.NormalizedType{},
+						CustomType:          customtypes.JSONType{},
 					},
 					"stored_source": schema.StringAttribute{
 						Optional:            true,
 						MarkdownDescription: "Flag that indicates whether to store all fields (true) on Atlas Search. By default, Atlas doesn't store (false) the fields on Atlas Search.  Alternatively, you can specify an object that only contains the list of fields to store (include) or not store (exclude) on Atlas Search. Note that storing all fields (true) is not allowed for vector search indexes. To learn more, see Stored Source Fields.",
-						CustomType:          jsontypes.NormalizedType{},
+						CustomType:          customtypes.JSONType{},
 					},
 					"synonyms": schema.ListNestedAttribute{
 						Optional:            true,
@@ -162,8 +161,8 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 								"types": schema.ListAttribute{
 									Required:            true,
 									MarkdownDescription: "List of types associated with the type set. Each type definition must include a `type` field specifying the search field type (`autocomplete`, `boolean`, `date`, `geo`, `number`, `objectId`, `string`, `token`, or `uuid`) and may include additional configuration properties specific to that type.",
-									CustomType:          customtypes.NewListType[jsontypes.Normalized](ctx),
-									ElementType:         jsontypes.NormalizedType{},
+									CustomType:          customtypes.NewListType[customtypes.JSONValue](ctx),
+									ElementType:         customtypes.JSONType{},
 								},
 							},
 						},
@@ -196,8 +195,8 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 								"char_filters": schema.ListAttribute{
 									Computed:            true,
 									MarkdownDescription: "Filters that examine text one character at a time and perform filtering operations.",
-									CustomType:          customtypes.NewListType[jsontypes.Normalized](ctx),
-									ElementType:         jsontypes.NormalizedType{},
+									CustomType:          customtypes.NewListType[customtypes.JSONValue](ctx),
+									ElementType:         customtypes.JSONType{},
 								},
 								"name": schema.StringAttribute{
 									Computed:            true,
@@ -206,14 +205,14 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 								"token_filters": schema.ListAttribute{
 									Computed:            true,
 									MarkdownDescription: "Filter that performs operations such as:\n\n- Stemming, which reduces related words, such as \"talking\", \"talked\", and \"talks\" to their root word \"talk\".\n\n- Redaction, which is the removal of sensitive information from public documents.",
-									CustomType:          customtypes.NewListType[jsontypes.Normalized](ctx),
-									ElementType:         jsontypes.NormalizedType{},
+									CustomType:          customtypes.NewListType[customtypes.JSONValue](ctx),
+									ElementType:         customtypes.JSONType{},
 								},
 								"tokenizer": schema.MapAttribute{
 									Computed:            true,
 									MarkdownDescription: "Tokenizer that you want to use to create tokens. Tokens determine how Atlas Search splits up text into discrete chunks for indexing.",
-									CustomType:          customtypes.NewMapType[jsontypes.Normalized](ctx),
-									ElementType:         jsontypes.NormalizedType{},
+									CustomType:          customtypes.NewMapType[customtypes.JSONValue](ctx),
+									ElementType:         customtypes.JSONType{},
 								},
 							},
 						},
@@ -221,8 +220,8 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 					"fields": schema.ListAttribute{
 						Computed:            true,
 						MarkdownDescription: "Settings that configure the fields, one per object, to index. You must define at least one \"vector\" type field. You can optionally define \"filter\" type fields also.",
-						CustomType:          customtypes.NewListType[jsontypes.Normalized](ctx),
-						ElementType:         jsontypes.NormalizedType{},
+						CustomType:          customtypes.NewListType[customtypes.JSONValue](ctx),
+						ElementType:         customtypes.JSONType{},
 					},
 					"mappings": schema.SingleNestedAttribute{
 						Computed:            true,
@@ -232,13 +231,13 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 							"dynamic": schema.StringAttribute{
 								Computed:            true,
 								MarkdownDescription: "Indicates whether the index uses static, default dynamic, or configurable dynamic mappings. Set to `true` to enable dynamic mapping with default type set or define object to specify the name of the configured type sets for dynamic mapping. If you specify configurable dynamic mappings, you must define the referred type sets in the `typeSets` field. Set to `false` to use only static mappings through `mappings.fields`.",
-								CustomType:          jsontypes.NormalizedType{},
+								CustomType:          customtypes.JSONType{},
 							},
 							"fields": schema.MapAttribute{
 								Computed:            true,
 								MarkdownDescription: "One or more field specifications for the Atlas Search index. Required if `mappings.dynamic` is omitted or set to `false`.",
-								CustomType:          customtypes.NewMapType[jsontypes.Normalized](ctx),
-								ElementType:         jsontypes.NormalizedType{},
+								CustomType:          customtypes.NewMapType[customtypes.JSONValue](ctx),
+								ElementType:         customtypes.JSONType{},
 							},
 						},
 					},
@@ -257,12 +256,12 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 					"sort": schema.StringAttribute{
 						Computed:            true,
 						MarkdownDescription: "Sort definition for the index. When defined, the index will be pre-sorted on the specified fields, which improves query sort performance for those fields. Supports two formats: simple format with field name and direction, or complex format with additional options. The `order` field is required (1=ascending, -1=descending).The `noData` field is optional and controls how missing values are sorted(default: \"lowest\").",
-						CustomType:          jsontypes.NormalizedType{},
+						CustomType:          customtypes.JSONType{},
 					},
 					"stored_source": schema.StringAttribute{
 						Computed:            true,
 						MarkdownDescription: "Flag that indicates whether to store all fields (true) on Atlas Search. By default, Atlas doesn't store (false) the fields on Atlas Search.  Alternatively, you can specify an object that only contains the list of fields to store (include) or not store (exclude) on Atlas Search. Note that storing all fields (true) is not allowed for vector search indexes. To learn more, see Stored Source Fields.",
-						CustomType:          jsontypes.NormalizedType{},
+						CustomType:          customtypes.JSONType{},
 					},
 					"synonyms": schema.ListNestedAttribute{
 						Computed:            true,
@@ -305,8 +304,8 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 								"types": schema.ListAttribute{
 									Computed:            true,
 									MarkdownDescription: "List of types associated with the type set. Each type definition must include a `type` field specifying the search field type (`autocomplete`, `boolean`, `date`, `geo`, `number`, `objectId`, `string`, `token`, or `uuid`) and may include additional configuration properties specific to that type.",
-									CustomType:          customtypes.NewListType[jsontypes.Normalized](ctx),
-									ElementType:         jsontypes.NormalizedType{},
+									CustomType:          customtypes.NewListType[customtypes.JSONValue](ctx),
+									ElementType:         customtypes.JSONType{},
 								},
 							},
 						},
@@ -364,8 +363,8 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 										"fields": schema.ListAttribute{
 											Computed:            true,
 											MarkdownDescription: "Settings that configure the fields, one per object, to index. You must define at least one \"vector\" type field. You can optionally define \"filter\" type fields also.",
-											CustomType:          customtypes.NewListType[jsontypes.Normalized](ctx),
-											ElementType:         jsontypes.NormalizedType{},
+											CustomType:          customtypes.NewListType[customtypes.JSONValue](ctx),
+											ElementType:         customtypes.JSONType{},
 										},
 										"nested_root": schema.StringAttribute{
 											Computed:            true,
@@ -378,7 +377,7 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 										"stored_source": schema.StringAttribute{
 											Computed:            true,
 											MarkdownDescription: "Flag that indicates whether to store all fields (true) on Atlas Search. By default, Atlas doesn't store (false) the fields on Atlas Search.  Alternatively, you can specify an object that only contains the list of fields to store (include) or not store (exclude) on Atlas Search. Note that storing all fields (true) is not allowed for vector search indexes. To learn more, see Stored Source Fields.",
-											CustomType:          jsontypes.NormalizedType{},
+											CustomType:          customtypes.JSONType{},
 										},
 									},
 								},
@@ -428,8 +427,8 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 										"fields": schema.ListAttribute{
 											Computed:            true,
 											MarkdownDescription: "Settings that configure the fields, one per object, to index. You must define at least one \"vector\" type field. You can optionally define \"filter\" type fields also.",
-											CustomType:          customtypes.NewListType[jsontypes.Normalized](ctx),
-											ElementType:         jsontypes.NormalizedType{},
+											CustomType:          customtypes.NewListType[customtypes.JSONValue](ctx),
+											ElementType:         customtypes.JSONType{},
 										},
 										"nested_root": schema.StringAttribute{
 											Computed:            true,
@@ -442,7 +441,7 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 										"stored_source": schema.StringAttribute{
 											Computed:            true,
 											MarkdownDescription: "Flag that indicates whether to store all fields (true) on Atlas Search. By default, Atlas doesn't store (false) the fields on Atlas Search.  Alternatively, you can specify an object that only contains the list of fields to store (include) or not store (exclude) on Atlas Search. Note that storing all fields (true) is not allowed for vector search indexes. To learn more, see Stored Source Fields.",
-											CustomType:          jsontypes.NormalizedType{},
+											CustomType:          customtypes.JSONType{},
 										},
 									},
 								},
@@ -489,8 +488,8 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 			"synonym_mapping_status_detail": schema.ListAttribute{
 				Computed:            true,
 				MarkdownDescription: "Optional for type: search. A list of documents describing the status of the index's synonym mappings on each search host. Only appears if the index has synonyms defined.",
-				CustomType:          customtypes.NewListType[jsontypes.Normalized](ctx),
-				ElementType:         jsontypes.NormalizedType{},
+				CustomType:          customtypes.NewListType[customtypes.JSONValue](ctx),
+				ElementType:         customtypes.JSONType{},
 			},
 			"type": schema.StringAttribute{
 				Optional:            true,
@@ -526,7 +525,7 @@ type TFModel struct {
 	Status                     types.String                                            `tfsdk:"status" autogen:"omitjson"`
 	StatusDetail               customtypes.NestedListValue[TFStatusDetailModel]        `tfsdk:"status_detail" autogen:"omitjson"`
 	SynonymMappingStatus       types.String                                            `tfsdk:"synonym_mapping_status" autogen:"omitjson"`
-	SynonymMappingStatusDetail customtypes.ListValue[jsontypes.Normalized]             `tfsdk:"synonym_mapping_status_detail" autogen:"omitjson"`
+	SynonymMappingStatusDetail customtypes.ListValue[customtypes.JSONValue]            `tfsdk:"synonym_mapping_status_detail" autogen:"omitjson"`
 	Type                       types.String                                            `tfsdk:"type" autogen:"omitjsonupdate"`
 	DeleteOnCreateTimeout      types.Bool                                              `tfsdk:"delete_on_create_timeout" autogen:"omitjson"`
 	Timeouts                   timeouts.Value                                          `tfsdk:"timeouts" autogen:"omitjson"`
@@ -534,25 +533,25 @@ type TFModel struct {
 type TFDefinitionModel struct {
 	Analyzer       types.String                                            `tfsdk:"analyzer"`
 	Analyzers      customtypes.NestedListValue[TFDefinitionAnalyzersModel] `tfsdk:"analyzers"`
-	Fields         customtypes.ListValue[jsontypes.Normalized]             `tfsdk:"fields"`
+	Fields         customtypes.ListValue[customtypes.JSONValue]            `tfsdk:"fields"`
 	Mappings       customtypes.ObjectValue[TFDefinitionMappingsModel]      `tfsdk:"mappings"`
 	NestedRoot     types.String                                            `tfsdk:"nested_root"`
 	NumPartitions  types.Int64                                             `tfsdk:"num_partitions"`
 	SearchAnalyzer types.String                                            `tfsdk:"search_analyzer"`
-	Sort           jsontypes.Normalized                                    `tfsdk:"sort"`
-	StoredSource   jsontypes.Normalized                                    `tfsdk:"stored_source"`
+	Sort           customtypes.JSONValue                                   `tfsdk:"sort"`
+	StoredSource   customtypes.JSONValue                                   `tfsdk:"stored_source"`
 	Synonyms       customtypes.NestedListValue[TFDefinitionSynonymsModel]  `tfsdk:"synonyms"`
 	TypeSets       customtypes.NestedListValue[TFDefinitionTypeSetsModel]  `tfsdk:"type_sets" autogen:"sendnullasnullonupdate"`
 }
 type TFDefinitionAnalyzersModel struct {
-	CharFilters  customtypes.ListValue[jsontypes.Normalized] `tfsdk:"char_filters"`
-	Name         types.String                                `tfsdk:"name"`
-	TokenFilters customtypes.ListValue[jsontypes.Normalized] `tfsdk:"token_filters"`
-	Tokenizer    customtypes.MapValue[jsontypes.Normalized]  `tfsdk:"tokenizer"`
+	CharFilters  customtypes.ListValue[customtypes.JSONValue] `tfsdk:"char_filters"`
+	Name         types.String                                 `tfsdk:"name"`
+	TokenFilters customtypes.ListValue[customtypes.JSONValue] `tfsdk:"token_filters"`
+	Tokenizer    customtypes.MapValue[customtypes.JSONValue]  `tfsdk:"tokenizer"`
 }
 type TFDefinitionMappingsModel struct {
-	Dynamic jsontypes.Normalized                       `tfsdk:"dynamic"`
-	Fields  customtypes.MapValue[jsontypes.Normalized] `tfsdk:"fields"`
+	Dynamic customtypes.JSONValue                       `tfsdk:"dynamic"`
+	Fields  customtypes.MapValue[customtypes.JSONValue] `tfsdk:"fields"`
 }
 type TFDefinitionSynonymsModel struct {
 	Analyzer types.String                                             `tfsdk:"analyzer"`
@@ -563,31 +562,31 @@ type TFDefinitionSynonymsSourceModel struct {
 	Collection types.String `tfsdk:"collection"`
 }
 type TFDefinitionTypeSetsModel struct {
-	Name  types.String                                `tfsdk:"name"`
-	Types customtypes.ListValue[jsontypes.Normalized] `tfsdk:"types"`
+	Name  types.String                                 `tfsdk:"name"`
+	Types customtypes.ListValue[customtypes.JSONValue] `tfsdk:"types"`
 }
 type TFLatestDefinitionModel struct {
 	Analyzer       types.String                                                  `tfsdk:"analyzer" autogen:"omitjson"`
 	Analyzers      customtypes.NestedListValue[TFLatestDefinitionAnalyzersModel] `tfsdk:"analyzers" autogen:"omitjson"`
-	Fields         customtypes.ListValue[jsontypes.Normalized]                   `tfsdk:"fields" autogen:"omitjson"`
+	Fields         customtypes.ListValue[customtypes.JSONValue]                  `tfsdk:"fields" autogen:"omitjson"`
 	Mappings       customtypes.ObjectValue[TFLatestDefinitionMappingsModel]      `tfsdk:"mappings" autogen:"omitjson"`
 	NestedRoot     types.String                                                  `tfsdk:"nested_root" autogen:"omitjson"`
 	NumPartitions  types.Int64                                                   `tfsdk:"num_partitions" autogen:"omitjson"`
 	SearchAnalyzer types.String                                                  `tfsdk:"search_analyzer" autogen:"omitjson"`
-	Sort           jsontypes.Normalized                                          `tfsdk:"sort" autogen:"omitjson"`
-	StoredSource   jsontypes.Normalized                                          `tfsdk:"stored_source" autogen:"omitjson"`
+	Sort           customtypes.JSONValue                                         `tfsdk:"sort" autogen:"omitjson"`
+	StoredSource   customtypes.JSONValue                                         `tfsdk:"stored_source" autogen:"omitjson"`
 	Synonyms       customtypes.NestedListValue[TFLatestDefinitionSynonymsModel]  `tfsdk:"synonyms" autogen:"omitjson"`
 	TypeSets       customtypes.NestedListValue[TFLatestDefinitionTypeSetsModel]  `tfsdk:"type_sets" autogen:"omitjson"`
 }
 type TFLatestDefinitionAnalyzersModel struct {
-	CharFilters  customtypes.ListValue[jsontypes.Normalized] `tfsdk:"char_filters" autogen:"omitjson"`
-	Name         types.String                                `tfsdk:"name" autogen:"omitjson"`
-	TokenFilters customtypes.ListValue[jsontypes.Normalized] `tfsdk:"token_filters" autogen:"omitjson"`
-	Tokenizer    customtypes.MapValue[jsontypes.Normalized]  `tfsdk:"tokenizer" autogen:"omitjson"`
+	CharFilters  customtypes.ListValue[customtypes.JSONValue] `tfsdk:"char_filters" autogen:"omitjson"`
+	Name         types.String                                 `tfsdk:"name" autogen:"omitjson"`
+	TokenFilters customtypes.ListValue[customtypes.JSONValue] `tfsdk:"token_filters" autogen:"omitjson"`
+	Tokenizer    customtypes.MapValue[customtypes.JSONValue]  `tfsdk:"tokenizer" autogen:"omitjson"`
 }
 type TFLatestDefinitionMappingsModel struct {
-	Dynamic jsontypes.Normalized                       `tfsdk:"dynamic" autogen:"omitjson"`
-	Fields  customtypes.MapValue[jsontypes.Normalized] `tfsdk:"fields" autogen:"omitjson"`
+	Dynamic customtypes.JSONValue                       `tfsdk:"dynamic" autogen:"omitjson"`
+	Fields  customtypes.MapValue[customtypes.JSONValue] `tfsdk:"fields" autogen:"omitjson"`
 }
 type TFLatestDefinitionSynonymsModel struct {
 	Analyzer types.String                                                   `tfsdk:"analyzer" autogen:"omitjson"`
@@ -598,8 +597,8 @@ type TFLatestDefinitionSynonymsSourceModel struct {
 	Collection types.String `tfsdk:"collection" autogen:"omitjson"`
 }
 type TFLatestDefinitionTypeSetsModel struct {
-	Name  types.String                                `tfsdk:"name" autogen:"omitjson"`
-	Types customtypes.ListValue[jsontypes.Normalized] `tfsdk:"types" autogen:"omitjson"`
+	Name  types.String                                 `tfsdk:"name" autogen:"omitjson"`
+	Types customtypes.ListValue[customtypes.JSONValue] `tfsdk:"types" autogen:"omitjson"`
 }
 type TFLatestDefinitionVersionModel struct {
 	CreatedAt types.String `tfsdk:"created_at" autogen:"omitjson"`
@@ -620,10 +619,10 @@ type TFStatusDetailMainIndexModel struct {
 	Status            types.String                                                           `tfsdk:"status" autogen:"omitjson"`
 }
 type TFStatusDetailMainIndexDefinitionModel struct {
-	Fields        customtypes.ListValue[jsontypes.Normalized] `tfsdk:"fields" autogen:"omitjson"`
-	NestedRoot    types.String                                `tfsdk:"nested_root" autogen:"omitjson"`
-	NumPartitions types.Int64                                 `tfsdk:"num_partitions" autogen:"omitjson"`
-	StoredSource  jsontypes.Normalized                        `tfsdk:"stored_source" autogen:"omitjson"`
+	Fields        customtypes.ListValue[customtypes.JSONValue] `tfsdk:"fields" autogen:"omitjson"`
+	NestedRoot    types.String                                 `tfsdk:"nested_root" autogen:"omitjson"`
+	NumPartitions types.Int64                                  `tfsdk:"num_partitions" autogen:"omitjson"`
+	StoredSource  customtypes.JSONValue                        `tfsdk:"stored_source" autogen:"omitjson"`
 }
 type TFStatusDetailMainIndexDefinitionVersionModel struct {
 	CreatedAt types.String `tfsdk:"created_at" autogen:"omitjson"`
@@ -637,10 +636,10 @@ type TFStatusDetailStagedIndexModel struct {
 	Status            types.String                                                             `tfsdk:"status" autogen:"omitjson"`
 }
 type TFStatusDetailStagedIndexDefinitionModel struct {
-	Fields        customtypes.ListValue[jsontypes.Normalized] `tfsdk:"fields" autogen:"omitjson"`
-	NestedRoot    types.String                                `tfsdk:"nested_root" autogen:"omitjson"`
-	NumPartitions types.Int64                                 `tfsdk:"num_partitions" autogen:"omitjson"`
-	StoredSource  jsontypes.Normalized                        `tfsdk:"stored_source" autogen:"omitjson"`
+	Fields        customtypes.ListValue[customtypes.JSONValue] `tfsdk:"fields" autogen:"omitjson"`
+	NestedRoot    types.String                                 `tfsdk:"nested_root" autogen:"omitjson"`
+	NumPartitions types.Int64                                  `tfsdk:"num_partitions" autogen:"omitjson"`
+	StoredSource  customtypes.JSONValue                        `tfsdk:"stored_source" autogen:"omitjson"`
 }
 type TFStatusDetailStagedIndexDefinitionVersionModel struct {
 	CreatedAt types.String `tfsdk:"created_at" autogen:"omitjson"`
@@ -5,7 +5,6 @@ package streamprocessorapi
 import (
 	"context"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -69,8 +68,8 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 			"pipeline": schema.ListAttribute{
 				Optional:            true,
 				MarkdownDescription: "Stream aggregation pipeline you want to apply to your streaming data.",
-				CustomType:          customtypes.NewListType[jsontypes.Normalized](ctx),
-				ElementType:         jsontypes.NormalizedType{},
+				CustomType:          customtypes.NewListType[customtypes.JSONValue](ctx),
+				ElementType:         customtypes.JSONType{},
 			},
 			"state": schema.StringAttribute{
 				Computed:            true,
@@ -79,8 +78,8 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 			"stats": schema.MapAttribute{
 				Computed:            true,
 				MarkdownDescription: "The stats associated with the stream processor.",
-				CustomType:          customtypes.NewMapType[jsontypes.Normalized](ctx),
-				ElementType:         jsontypes.NormalizedType{},
+				CustomType:          customtypes.NewMapType[customtypes.JSONValue](ctx),
+				ElementType:         customtypes.JSONType{},
 			},
 			"tenant_name": schema.StringAttribute{
 				Required:            true,
@@ -108,18 +107,18 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type TFModel struct {
-	EligibleForFailover   types.Bool                                  `tfsdk:"eligible_for_failover" autogen:"omitjson"`
-	FailoverEnabled       types.Bool                                  `tfsdk:"failover_enabled"`
-	GroupId               types.String                                `tfsdk:"group_id" autogen:"omitjson"`
-	Name                  types.String                                `tfsdk:"name"`
-	Options               customtypes.ObjectValue[TFOptionsModel]     `tfsdk:"options"`
-	Pipeline              customtypes.ListValue[jsontypes.Normalized] `tfsdk:"pipeline"`
-	State                 types.String                                `tfsdk:"state" autogen:"omitjson"`
-	Stats                 customtypes.MapValue[jsontypes.Normalized]  `tfsdk:"stats" autogen:"omitjson"`
-	TenantName            types.String                                `tfsdk:"tenant_name" autogen:"omitjson"`
-	Tier                  types.String                                `tfsdk:"tier"`
-	DeleteOnCreateTimeout types.Bool                                  `tfsdk:"delete_on_create_timeout" autogen:"omitjson"`
-	Timeouts              timeouts.Value                              `tfsdk:"timeouts" autogen:"omitjson"`
+	EligibleForFailover   types.Bool                                   `tfsdk:"eligible_for_failover" autogen:"omitjson"`
+	FailoverEnabled       types.Bool                                   `tfsdk:"failover_enabled"`
+	GroupId               types.String                                 `tfsdk:"group_id" autogen:"omitjson"`
+	Name                  types.String                                 `tfsdk:"name"`
+	Options               customtypes.ObjectValue[TFOptionsModel]      `tfsdk:"options"`
+	Pipeline              customtypes.ListValue[customtypes.JSONValue] `tfsdk:"pipeline"`
+	State                 types.String                                 `tfsdk:"state" autogen:"omitjson"`
+	Stats                 customtypes.MapValue[customtypes.JSONValue]  `tfsdk:"stats" autogen:"omitjson"`
+	TenantName            types.String                                 `tfsdk:"tenant_name" autogen:"omitjson"`
+	Tier                  types.String                                 `tfsdk:"tier"`
+	DeleteOnCreateTimeout types.Bool                                   `tfsdk:"delete_on_create_timeout" autogen:"omitjson"`
+	Timeouts              timeouts.Value                               `tfsdk:"timeouts" autogen:"omitjson"`
 }
 type TFOptionsModel struct {
 	Dlq                  customtypes.ObjectValue[TFOptionsDlqModel] `tfsdk:"dlq"`
@@ -52,10 +52,14 @@ var (
 
 func TestStepImportCluster(resourceName string, ignorePrefixFields ...string) resource.TestStep {
 	ignorePrefixFields = append(ignorePrefixFields,
-		"retain_backups_enabled",   // This field is TF specific and not returned by Atlas, so Import can't fill it in.
-		"mongo_db_major_version",   // Risks plan change of 8 --> 8.0 (always normalized to `major.minor`)
-		"state_name",               // Cluster state can change from IDLE to UPDATING and risks making the test flaky
-		"delete_on_create_timeout", // This field is TF specific and not returned by Atlas, so Import can't fill it in.
+		"retain_backups_enabled",               // This field is TF specific and not returned by Atlas, so Import can't fill it in.
+		"mongo_db_major_version",               // Risks plan change of 8 --> 8.0 (always normalized to `major.minor`)
+		"state_name",                           // Cluster state can change from IDLE to UPDATING and risks making the test flaky
+		"delete_on_create_timeout",             // This field is TF specific and not returned by Atlas, so Import can't fill it in.
+		"staged_major_version_upgrade",         // This field is TF specific and not returned by Atlas, so Import can't fill it in.
+		"pin_fcv_during_major_version_upgrade", // This field is TF specific and not returned by Atlas, so Import can't fill it in.
+		"require_ack_for_disruptive_changes",   // This field is TF specific and not returned by Atlas, so Import can't fill it in.
+		"acknowledged_disruptive_changes",      // This field is TF specific and not returned by Atlas, so Import can't fill it in.
 	)
 
 	return resource.TestStep{
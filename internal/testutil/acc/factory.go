@@ -50,7 +50,7 @@ func ConnV2UsingGov() *admin.APIClient {
 		PrivateKey: os.Getenv("MONGODB_ATLAS_GOV_PRIVATE_KEY"),
 		BaseURL:    os.Getenv("MONGODB_ATLAS_GOV_BASE_URL"),
 	}
-	client, err := config.NewClient(c, "")
+	client, err := config.NewClient(c, "", nil)
 	if err != nil {
 		log.Fatalf("failed to create Atlas (gov) client for acceptance tests: %v", err)
 	}
@@ -82,7 +82,7 @@ func init() {
 		RealmBaseURL: os.Getenv("MONGODB_REALM_BASE_URL"),
 	}
 	var err error
-	MongoDBClient, err = config.NewClient(c, "")
+	MongoDBClient, err = config.NewClient(c, "", nil)
 	if err != nil {
 		log.Fatalf("failed to initialize Atlas client for acceptance tests: %v", err)
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 )
 
@@ -27,3 +28,17 @@ func IDWithProjectIDClusterName(projectID, clusterName string) (string, error) {
 	}
 	return projectID + "-" + clusterName, nil
 }
+
+// IdentityVersionChecks skips the test in Terraform versions without resource identity support.
+func IdentityVersionChecks() []tfversion.TerraformVersionCheck {
+	return []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_12_0)}
+}
+
+// ImportStepWithIdentity imports the resource with an import block that uses its resource identity, the import is expected to plan no changes.
+func ImportStepWithIdentity(resourceName string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:    resourceName,
+		ImportState:     true,
+		ImportStateKind: resource.ImportBlockWithResourceIdentity,
+	}
+}
@@ -0,0 +1,67 @@
+package unit
+
+import (
+	"fmt"
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+// InvokeAction calls Invoke on an action using the given Atlas client and attributes as config. Attributes not set are null.
+// Returns the invoke response together with the progress messages sent by the action.
+func InvokeAction(t *testing.T, iAction action.Action, atlasV2 *admin.APIClient, attributes map[string]any) (resp *action.InvokeResponse, progress []string) {
+	t.Helper()
+	ctx := t.Context()
+	implemented, ok := iAction.(config.ImplementedAction)
+	if !ok {
+		t.Fatalf("action %T didn't comply with the ImplementedAction interface", iAction)
+	}
+	implemented.SetClient(&config.MongoDBClient{AtlasV2: atlasV2})
+
+	schemaResp := &action.SchemaResponse{}
+	iAction.Schema(ctx, action.SchemaRequest{}, schemaResp)
+	AssertDiagsOK(t, schemaResp.Diagnostics)
+	req := action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    configValue(t, schemaResp.Schema.Type().TerraformType(ctx), attributes, fmt.Sprintf("action %T", iAction)),
+		},
+	}
+	resp = &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+	iAction.Invoke(ctx, req, resp)
+	return resp, progress
+}
+
+// configValue returns the config object of a schema with the given attributes, attributes not set are null.
+// name identifies the schema owner in the failure messages.
+func configValue(t *testing.T, schemaType tftypes.Type, attributes map[string]any, name string) tftypes.Value {
+	t.Helper()
+	objType, ok := schemaType.(tftypes.Object)
+	if !ok {
+		t.Fatalf("%s schema is not an object", name)
+	}
+	values := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for attrName, attrType := range objType.AttributeTypes {
+		if value, found := attributes[attrName]; found {
+			values[attrName] = tftypes.NewValue(attrType, value)
+		} else {
+			values[attrName] = tftypes.NewValue(attrType, nil)
+		}
+	}
+	for attrName := range attributes {
+		if _, found := objType.AttributeTypes[attrName]; !found {
+			t.Fatalf("attribute %s not found in %s schema", attrName, name)
+		}
+	}
+	return tftypes.NewValue(objType, values)
+}
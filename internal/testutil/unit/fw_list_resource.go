@@ -0,0 +1,81 @@
+package unit
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312023/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+// ListRequest is the request sent by ListResources, Attributes is the list block config. Attributes not set are null.
+type ListRequest struct {
+	Attributes      map[string]any
+	Limit           int64
+	IncludeResource bool
+}
+
+// ListResources calls List on a list resource using the given Atlas client, iResource is the listed resource.
+// Returns the results sent to the stream.
+func ListResources(t *testing.T, iListResource list.ListResource, iResource resource.Resource, atlasV2 *admin.APIClient, req ListRequest) []list.ListResult {
+	t.Helper()
+	ctx := t.Context()
+	implemented, ok := iListResource.(config.ImplementedListResource)
+	if !ok {
+		t.Fatalf("list resource %T didn't comply with the ImplementedListResource interface", iListResource)
+	}
+	implemented.SetClient(&config.MongoDBClient{AtlasV2: atlasV2})
+	withIdentity, ok := iResource.(resource.ResourceWithIdentity)
+	if !ok {
+		t.Fatalf("resource %T doesn't implement ResourceWithIdentity", iResource)
+	}
+
+	schemaResp := &list.ListResourceSchemaResponse{}
+	iListResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, schemaResp)
+	AssertDiagsOK(t, schemaResp.Diagnostics)
+	resourceSchemaResp := &resource.SchemaResponse{}
+	iResource.Schema(ctx, resource.SchemaRequest{}, resourceSchemaResp)
+	AssertDiagsOK(t, resourceSchemaResp.Diagnostics)
+	identitySchemaResp := &resource.IdentitySchemaResponse{}
+	withIdentity.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identitySchemaResp)
+	AssertDiagsOK(t, identitySchemaResp.Diagnostics)
+
+	listReq := list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    configValue(t, schemaResp.Schema.Type().TerraformType(ctx), req.Attributes, fmt.Sprintf("list resource %T", iListResource)),
+		},
+		IncludeResource:        req.IncludeResource,
+		Limit:                  req.Limit,
+		ResourceSchema:         resourceSchemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}
+	stream := &list.ListResultsStream{}
+	iListResource.List(ctx, listReq, stream)
+	if stream.Results == nil {
+		t.Fatalf("list resource %T didn't set the results", iListResource)
+	}
+	return slices.Collect(stream.Results)
+}
+
+// ListResultIdentity returns the identity attribute values of a list result, all identity attributes are strings.
+func ListResultIdentity(t *testing.T, result *list.ListResult) map[string]string {
+	t.Helper()
+	var values map[string]tftypes.Value
+	require.NoError(t, result.Identity.Raw.As(&values))
+	identity := make(map[string]string, len(values))
+	for name, value := range values {
+		var str string
+		require.NoError(t, value.As(&str))
+		identity[name] = str
+	}
+	return identity
+}
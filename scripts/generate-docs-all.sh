@@ -23,6 +23,7 @@
 # templates/resources/${resource_name}.md.tmpl and 
 # templates/data-sources/${resource_name}.md.tmpl
 # templates/data-sources/${resource_name}s.md.tmpl
+# templates/functions/${function_name}.md.tmpl
 
 set -euo pipefail
 
@@ -62,6 +63,15 @@ for file in "$TEMPLATE_FOLDER_PATH/ephemeral-resources"/*; do
     fi
 done
 
+for file in "$TEMPLATE_FOLDER_PATH/functions"/*; do
+    filenameTemplate=$(basename -- "$file")
+    filename="${filenameTemplate%.*}"
+    if [ -f "docs-out/functions/${filename}" ]; then
+        printf "Function file moved: %s\n" "${filename}"
+        mv "docs-out/functions/${filename}" "docs/functions/${filename}"
+    fi
+done
+
 # moving generated docs for autogenerated resources and data sources which use default template (ending with _api or _api_v*)
 for file in docs-out/resources/*; do
     if [ -f "$file" ]; then
@@ -0,0 +1,619 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/gocty"
+)
+
+const (
+	clusterResourceType         = "mongodbatlas_cluster"
+	advancedClusterResourceType = "mongodbatlas_advanced_cluster"
+	providerTenant              = "TENANT"
+	defaultPriority             = 7
+	defaultNodeCount            = 3
+)
+
+var (
+	// metaArguments are Terraform meta-arguments that are kept as they are.
+	metaArguments = []string{"count", "for_each", "provider", "depends_on"}
+	// copiedAttrs have the same name and meaning in both resources.
+	copiedAttrs = []string{
+		"project_id", "name", "cluster_type", "mongo_db_major_version", "version_release_system", "pit_enabled", "paused",
+		"termination_protection_enabled", "encryption_at_rest_provider", "redact_client_log_data", "retain_backups_enabled",
+		"accept_data_risks_and_force_replica_set_reconfig",
+	}
+	// renamedAttrs have a different name in mongodbatlas_advanced_cluster.
+	renamedAttrs = map[string]string{"cloud_backup": "backup_enabled"}
+	// regionAttrs are moved to region_configs of every replication spec.
+	regionAttrs = []string{
+		"provider_name", "backing_provider_name", "provider_region_name", "provider_instance_size_name", "disk_size_gb",
+		"provider_disk_iops", "provider_volume_type", "replication_factor", "num_shards",
+	}
+	// autoScalingAttrs are moved to auto_scaling of every region config.
+	autoScalingAttrs = map[string]string{
+		"auto_scaling_disk_gb_enabled":                    "disk_gb_enabled",
+		"auto_scaling_compute_enabled":                    "compute_enabled",
+		"auto_scaling_compute_scale_down_enabled":         "compute_scale_down_enabled",
+		"provider_auto_scaling_compute_min_instance_size": "compute_min_instance_size",
+		"provider_auto_scaling_compute_max_instance_size": "compute_max_instance_size",
+	}
+	// droppedAttrs have no equivalent in mongodbatlas_advanced_cluster, they're removed with a warning.
+	droppedAttrs = map[string]string{
+		"backup_enabled":              "legacy backup is not supported, use cloud_backup to enable Cloud Backup",
+		"provider_disk_type_name":     "the Azure disk type is derived from disk_size_gb",
+		"provider_encrypt_ebs_volume": "all EBS volumes are encrypted by default",
+	}
+	// objectBlocks are nested blocks that are converted to object attributes, with the attributes that are dropped.
+	objectBlocks = map[string]map[string]string{
+		"advanced_configuration": {
+			"default_read_concern":    "it's removed in mongodbatlas_advanced_cluster",
+			"fail_index_key_too_long": "it's removed in mongodbatlas_advanced_cluster",
+		},
+		"bi_connector_config": {},
+		"pinned_fcv":          {"version": "it's computed in mongodbatlas_advanced_cluster"},
+		"timeouts":            {},
+	}
+	// mapBlocks are repeated key-value blocks that are converted to map attributes.
+	mapBlocks = []string{"tags", "labels"}
+	// regionAttrPaths are the objects and attributes of every region config where the regionAttrs and autoScalingAttrs are moved,
+	// used to rewrite lifecycle ignore_changes. An empty object is the region config and specsObject is every hardware spec.
+	regionAttrPaths = map[string][2]string{
+		"provider_name":               {"", "provider_name"},
+		"backing_provider_name":       {"", "backing_provider_name"},
+		"provider_region_name":        {"", "region_name"},
+		"provider_instance_size_name": {specsObject, "instance_size"},
+		"disk_size_gb":                {specsObject, "disk_size_gb"},
+		"provider_disk_iops":          {specsObject, "disk_iops"},
+		"provider_volume_type":        {specsObject, "ebs_volume_type"},
+		"replication_factor":          {"electable_specs", "node_count"},
+	}
+)
+
+const specsObject = "*_specs"
+
+// regionObjects has the object attributes, e.g. electable_specs or auto_scaling, of every region config of a generated replication spec.
+type regionObjects [][]string
+
+// converter converts the mongodbatlas_cluster resources of a Terraform configuration file.
+type converter struct {
+	src      []byte
+	warnings []string
+}
+
+// convertFile returns the configuration with every mongodbatlas_cluster resource replaced by an equivalent mongodbatlas_advanced_cluster
+// resource and a moved block, so the state is moved without replacing the cluster. The rest of the file is kept as it is.
+// It returns the number of converted resources and the warnings about attributes that are dropped.
+func convertFile(ctx context.Context, src []byte, filename string) (out []byte, converted int, warnings []string, err error) {
+	file, diags := hclsyntax.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, 0, nil, diags
+	}
+	c := &converter{src: src}
+	var clusters []*hclsyntax.Block
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		if block.Type == "resource" && len(block.Labels) == 2 && block.Labels[0] == clusterResourceType {
+			clusters = append(clusters, block)
+		}
+	}
+	generated := make([][]byte, len(clusters))
+	for i, block := range clusters {
+		if generated[i], err = c.convertCluster(block); err != nil {
+			return nil, 0, nil, fmt.Errorf("%s.%s: %w", clusterResourceType, block.Labels[1], err)
+		}
+		if err := validateAdvancedCluster(ctx, generated[i]); err != nil {
+			return nil, 0, nil, fmt.Errorf("%s.%s: generated configuration is not valid: %w", clusterResourceType, block.Labels[1], err)
+		}
+	}
+	out = src
+	// Replace from the end so the byte ranges of the previous blocks are still valid.
+	for i, block := range slices.Backward(clusters) {
+		blockRange := block.Range()
+		out = slices.Concat(out[:blockRange.Start.Byte], generated[i], movedBlock(block.Labels[1]), out[blockRange.End.Byte:])
+	}
+	return hclwrite.Format(out), len(clusters), c.warnings, nil
+}
+
+func (c *converter) warn(resourceName, attribute, reason string) {
+	c.warnings = append(c.warnings, fmt.Sprintf("%s.%s: %s is dropped, %s", clusterResourceType, resourceName, attribute, reason))
+}
+
+func (c *converter) convertCluster(block *hclsyntax.Block) ([]byte, error) {
+	resourceName := block.Labels[1]
+	for _, name := range []string{"provider_name", "provider_instance_size_name"} {
+		if block.Body.Attributes[name] == nil {
+			return nil, fmt.Errorf("attribute %s is required", name)
+		}
+	}
+	newBlock := hclwrite.NewBlock("resource", []string{advancedClusterResourceType, resourceName})
+	body := newBlock.Body()
+	for _, name := range metaArguments {
+		if attr := block.Body.Attributes[name]; attr != nil {
+			body.SetAttributeRaw(name, c.tokens(attr.Expr))
+		}
+	}
+	for _, attr := range sortedAttributes(block.Body) {
+		switch name := attr.Name; {
+		case slices.Contains(metaArguments, name), slices.Contains(regionAttrs, name), autoScalingAttrs[name] != "":
+		case slices.Contains(copiedAttrs, name):
+			body.SetAttributeRaw(name, c.tokens(attr.Expr))
+		case renamedAttrs[name] != "":
+			body.SetAttributeRaw(renamedAttrs[name], c.tokens(attr.Expr))
+		case droppedAttrs[name] != "":
+			c.warn(resourceName, name, droppedAttrs[name])
+		default:
+			return nil, fmt.Errorf("attribute %s is not supported", name)
+		}
+	}
+	if block.Body.Attributes["cluster_type"] == nil {
+		// cluster_type is optional in mongodbatlas_cluster and defaults to REPLICASET.
+		body.SetAttributeValue("cluster_type", cty.StringVal("REPLICASET"))
+	}
+	for _, nested := range block.Body.Blocks {
+		if nested.Type == "dynamic" {
+			return nil, fmt.Errorf("dynamic blocks are not supported, convert dynamic %q manually", nested.Labels[0])
+		}
+	}
+	replicationSpecs, layout, err := c.replicationSpecs(block.Body)
+	if err != nil {
+		return nil, err
+	}
+	body.SetAttributeRaw("replication_specs", replicationSpecs)
+
+	var lifecycleBlocks []*hclsyntax.Block
+	for _, nested := range block.Body.Blocks {
+		switch {
+		case nested.Type == "replication_specs":
+		case nested.Type == "lifecycle":
+			lifecycleBlocks = append(lifecycleBlocks, nested)
+		case slices.Contains(mapBlocks, nested.Type):
+			if body.GetAttribute(nested.Type) != nil {
+				continue
+			}
+			mapTokens, err := c.mapTokens(block.Body, nested.Type)
+			if err != nil {
+				return nil, err
+			}
+			body.SetAttributeRaw(nested.Type, mapTokens)
+		case objectBlocks[nested.Type] != nil:
+			body.SetAttributeRaw(nested.Type, c.objectTokens(resourceName, nested))
+		default:
+			return nil, fmt.Errorf("block %s is not supported", nested.Type)
+		}
+	}
+	for _, lifecycle := range lifecycleBlocks {
+		lifecycleBlock, err := c.lifecycleBlock(resourceName, lifecycle, layout)
+		if err != nil {
+			return nil, err
+		}
+		body.AppendNewline()
+		body.AppendBlock(lifecycleBlock)
+	}
+	body.AppendNewline()
+	body.AppendUnstructuredTokens(hclwrite.Tokens{{
+		Type:  hclsyntax.TokenComment,
+		Bytes: fmt.Appendf(nil, "# Generated by cluster-to-advanced-cluster, update the references to %s.%s\n", clusterResourceType, resourceName),
+	}})
+	return newBlock.BuildTokens(nil).Bytes(), nil
+}
+
+// regionConfig has the attributes of a regions_config block, or the top-level attributes if the cluster has no replication_specs.
+type regionConfig struct {
+	regionName, priority                          hclsyntax.Expression
+	electableNodes, readOnlyNodes, analyticsNodes hclsyntax.Expression
+}
+
+// replicationSpecs returns the replication_specs list, with a replication spec per shard as num_shards is not supported
+// in mongodbatlas_advanced_cluster. Region configs are sorted by descending priority when priorities are literals.
+// It also returns the object attributes of the region configs of every replication spec.
+func (c *converter) replicationSpecs(clusterBody *hclsyntax.Body) (hclwrite.Tokens, []regionObjects, error) {
+	var (
+		specs  []hclwrite.Tokens
+		layout []regionObjects
+	)
+	specBlocks := nestedBlocks(clusterBody, "replication_specs")
+	if len(specBlocks) == 0 {
+		regionName := clusterBody.Attributes["provider_region_name"]
+		if regionName == nil {
+			return nil, nil, fmt.Errorf("provider_region_name is required when there are no replication_specs blocks")
+		}
+		region := regionConfig{regionName: regionName.Expr}
+		if replicationFactor := clusterBody.Attributes["replication_factor"]; replicationFactor != nil {
+			region.electableNodes = replicationFactor.Expr
+		}
+		numShards, err := literalInt(clusterBody.Attributes["num_shards"], 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		spec, objects := c.replicationSpec(clusterBody, nil, []regionConfig{region})
+		for range numShards {
+			specs = append(specs, spec)
+			layout = append(layout, objects)
+		}
+		return hclwrite.TokensForTuple(specs), layout, nil
+	}
+	for _, specBlock := range specBlocks {
+		var regions []regionConfig
+		for _, regionBlock := range nestedBlocks(specBlock.Body, "regions_config") {
+			attrs := regionBlock.Body.Attributes
+			regions = append(regions, regionConfig{
+				regionName:     attrExpr(attrs, "region_name"),
+				priority:       attrExpr(attrs, "priority"),
+				electableNodes: attrExpr(attrs, "electable_nodes"),
+				readOnlyNodes:  attrExpr(attrs, "read_only_nodes"),
+				analyticsNodes: attrExpr(attrs, "analytics_nodes"),
+			})
+		}
+		if len(regions) == 0 {
+			return nil, nil, fmt.Errorf("replication_specs without regions_config blocks are not supported")
+		}
+		sortByPriority(regions)
+		numShards, err := literalInt(specBlock.Body.Attributes["num_shards"], 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		spec, objects := c.replicationSpec(clusterBody, specBlock.Body.Attributes["zone_name"], regions)
+		for range numShards {
+			specs = append(specs, spec)
+			layout = append(layout, objects)
+		}
+	}
+	return hclwrite.TokensForTuple(specs), layout, nil
+}
+
+func (c *converter) replicationSpec(clusterBody *hclsyntax.Body, zoneName *hclsyntax.Attribute, regions []regionConfig) (hclwrite.Tokens, regionObjects) {
+	regionConfigs := make([]hclwrite.Tokens, len(regions))
+	objects := make(regionObjects, len(regions))
+	for i := range regions {
+		regionConfigs[i], objects[i] = c.regionConfig(clusterBody, &regions[i])
+	}
+	var attrs []hclwrite.ObjectAttrTokens
+	if zoneName != nil {
+		attrs = append(attrs, objectAttr("zone_name", c.tokens(zoneName.Expr)))
+	}
+	attrs = append(attrs, objectAttr("region_configs", hclwrite.TokensForTuple(regionConfigs)))
+	return hclwrite.TokensForObject(attrs), objects
+}
+
+// regionConfig returns the region config and the names of its object attributes.
+func (c *converter) regionConfig(clusterBody *hclsyntax.Body, region *regionConfig) (hclwrite.Tokens, []string) {
+	clusterAttrs := clusterBody.Attributes
+	priority := hclwrite.TokensForValue(cty.NumberIntVal(defaultPriority))
+	if region.priority != nil {
+		priority = c.tokens(region.priority)
+	}
+	attrs := []hclwrite.ObjectAttrTokens{
+		objectAttr("provider_name", c.tokens(clusterAttrs["provider_name"].Expr)),
+	}
+	if backingProviderName := clusterAttrs["backing_provider_name"]; backingProviderName != nil {
+		attrs = append(attrs, objectAttr("backing_provider_name", c.tokens(backingProviderName.Expr)))
+	}
+	attrs = append(attrs,
+		objectAttr("region_name", c.tokens(region.regionName)),
+		objectAttr("priority", priority),
+	)
+	if isLiteralString(clusterAttrs["provider_name"].Expr, providerTenant) {
+		// Shared-tier clusters only have the instance size, nodes and disk are managed by Atlas.
+		attrs = append(attrs, objectAttr("electable_specs", hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+			objectAttr("instance_size", c.tokens(clusterAttrs["provider_instance_size_name"].Expr)),
+		})))
+		return hclwrite.TokensForObject(attrs), []string{"electable_specs"}
+	}
+	electableNodes := hclwrite.TokensForValue(cty.NumberIntVal(defaultNodeCount))
+	if region.electableNodes != nil {
+		electableNodes = c.tokens(region.electableNodes)
+	}
+	attrs = append(attrs, objectAttr("electable_specs", c.specs(clusterAttrs, electableNodes)))
+	objects := []string{"electable_specs"}
+	if hasNodes(region.readOnlyNodes) {
+		attrs = append(attrs, objectAttr("read_only_specs", c.specs(clusterAttrs, c.tokens(region.readOnlyNodes))))
+		objects = append(objects, "read_only_specs")
+	}
+	if hasNodes(region.analyticsNodes) {
+		attrs = append(attrs, objectAttr("analytics_specs", c.specs(clusterAttrs, c.tokens(region.analyticsNodes))))
+		objects = append(objects, "analytics_specs")
+	}
+	var autoScaling []hclwrite.ObjectAttrTokens
+	for _, attr := range sortedAttributes(clusterBody) {
+		if name := autoScalingAttrs[attr.Name]; name != "" {
+			autoScaling = append(autoScaling, objectAttr(name, c.tokens(attr.Expr)))
+		}
+	}
+	if len(autoScaling) > 0 {
+		attrs = append(attrs, objectAttr("auto_scaling", hclwrite.TokensForObject(autoScaling)))
+		objects = append(objects, "auto_scaling")
+	}
+	return hclwrite.TokensForObject(attrs), objects
+}
+
+func (c *converter) specs(clusterAttrs hclsyntax.Attributes, nodeCount hclwrite.Tokens) hclwrite.Tokens {
+	attrs := []hclwrite.ObjectAttrTokens{
+		objectAttr("instance_size", c.tokens(clusterAttrs["provider_instance_size_name"].Expr)),
+		objectAttr("node_count", nodeCount),
+	}
+	for _, names := range [][2]string{{"disk_size_gb", "disk_size_gb"}, {"provider_disk_iops", "disk_iops"}, {"provider_volume_type", "ebs_volume_type"}} {
+		if attr := clusterAttrs[names[0]]; attr != nil {
+			attrs = append(attrs, objectAttr(names[1], c.tokens(attr.Expr)))
+		}
+	}
+	return hclwrite.TokensForObject(attrs)
+}
+
+// mapTokens converts repeated blocks with key and value attributes, e.g. tags, to a map.
+func (c *converter) mapTokens(clusterBody *hclsyntax.Body, blockType string) (hclwrite.Tokens, error) {
+	var attrs []hclwrite.ObjectAttrTokens
+	for _, block := range nestedBlocks(clusterBody, blockType) {
+		key, value := block.Body.Attributes["key"], block.Body.Attributes["value"]
+		if key == nil || value == nil {
+			return nil, fmt.Errorf("%s blocks must have key and value", blockType)
+		}
+		attrs = append(attrs, hclwrite.ObjectAttrTokens{Name: c.keyTokens(key.Expr), Value: c.tokens(value.Expr)})
+	}
+	return hclwrite.TokensForObject(attrs), nil
+}
+
+// objectTokens converts a nested block, e.g. advanced_configuration, to an object.
+func (c *converter) objectTokens(resourceName string, block *hclsyntax.Block) hclwrite.Tokens {
+	dropped := objectBlocks[block.Type]
+	var attrs []hclwrite.ObjectAttrTokens
+	for _, attr := range sortedAttributes(block.Body) {
+		if reason, ok := dropped[attr.Name]; ok {
+			c.warn(resourceName, block.Type+"."+attr.Name, reason)
+			continue
+		}
+		attrs = append(attrs, objectAttr(attr.Name, c.tokens(attr.Expr)))
+	}
+	return hclwrite.TokensForObject(attrs)
+}
+
+// lifecycleBlock converts a lifecycle block. The ignore_changes references to mongodbatlas_cluster attributes are rewritten
+// to the mongodbatlas_advanced_cluster attributes, references without an equivalent are dropped with a warning.
+func (c *converter) lifecycleBlock(resourceName string, block *hclsyntax.Block, layout []regionObjects) (*hclwrite.Block, error) {
+	newBlock := hclwrite.NewBlock("lifecycle", nil)
+	body := newBlock.Body()
+	for _, attr := range sortedAttributes(block.Body) {
+		if attr.Name != "ignore_changes" || hcl.ExprAsKeyword(attr.Expr) == "all" {
+			body.SetAttributeRaw(attr.Name, c.tokens(attr.Expr))
+			continue
+		}
+		refs, diags := hcl.ExprList(attr.Expr)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("lifecycle ignore_changes must be a list of attribute references or all")
+		}
+		var paths []hclwrite.Tokens
+		for _, ref := range refs {
+			source := string(c.src[ref.Range().Start.Byte:ref.Range().End.Byte])
+			traversal, diags := hcl.AbsTraversalForExpr(ref)
+			if diags.HasErrors() {
+				return nil, fmt.Errorf("lifecycle ignore_changes reference %s is not an attribute reference", source)
+			}
+			refPaths := ignoreChangesPaths(traversal, layout)
+			if len(refPaths) == 0 {
+				c.warn(resourceName, "lifecycle.ignore_changes reference "+source, "it has no equivalent in mongodbatlas_advanced_cluster")
+				continue
+			}
+			for _, refPath := range refPaths {
+				paths = append(paths, hclwrite.TokensForTraversal(refPath))
+			}
+		}
+		if len(paths) > 0 {
+			body.SetAttributeRaw(attr.Name, multilineTuple(paths))
+		}
+	}
+	for _, nested := range block.Body.Blocks {
+		body.AppendNewline()
+		body.AppendUnstructuredTokens(c.rawTokens(nested.Range()))
+		body.AppendNewline()
+	}
+	return newBlock, nil
+}
+
+// ignoreChangesPaths returns the mongodbatlas_advanced_cluster paths of a mongodbatlas_cluster attribute reference,
+// nil if it has no equivalent. Attributes moved to the region configs return a path for every region config.
+func ignoreChangesPaths(ref hcl.Traversal, layout []regionObjects) []hcl.Traversal {
+	name, rest := ref.RootName(), ref[1:]
+	switch {
+	case slices.Contains(copiedAttrs, name), len(rest) == 0 && (name == "replication_specs" || slices.Contains(mapBlocks, name)):
+		return []hcl.Traversal{ref}
+	case renamedAttrs[name] != "":
+		return []hcl.Traversal{slices.Concat(hcl.Traversal{hcl.TraverseRoot{Name: renamedAttrs[name]}}, rest)}
+	case objectBlocks[name] != nil:
+		// Blocks are lists in mongodbatlas_cluster and objects in mongodbatlas_advanced_cluster, e.g. advanced_configuration[0].oplog_size_mb.
+		if index, ok := first(rest).(hcl.TraverseIndex); ok && index.Key.Equals(cty.Zero).True() {
+			rest = rest[1:]
+		}
+		switch step := first(rest).(type) {
+		case nil:
+			return []hcl.Traversal{{hcl.TraverseRoot{Name: name}}}
+		case hcl.TraverseAttr:
+			if _, dropped := objectBlocks[name][step.Name]; dropped || len(rest) > 1 {
+				return nil
+			}
+			return []hcl.Traversal{{hcl.TraverseRoot{Name: name}, step}}
+		}
+		return nil
+	case len(rest) > 0:
+		return nil
+	case autoScalingAttrs[name] != "":
+		return regionPaths(layout, "auto_scaling", autoScalingAttrs[name])
+	case regionAttrPaths[name] != [2]string{}:
+		return regionPaths(layout, regionAttrPaths[name][0], regionAttrPaths[name][1])
+	}
+	return nil
+}
+
+// regionPaths returns the path of an attribute in every region config, e.g. replication_specs[0].region_configs[0].electable_specs.instance_size.
+// object is empty for attributes of the region config, and specsObject for attributes of every hardware spec.
+func regionPaths(layout []regionObjects, object, attr string) []hcl.Traversal {
+	var paths []hcl.Traversal
+	for i, regions := range layout {
+		for j, objects := range regions {
+			regionPath := hcl.Traversal{
+				hcl.TraverseRoot{Name: "replication_specs"}, hcl.TraverseIndex{Key: cty.NumberIntVal(int64(i))},
+				hcl.TraverseAttr{Name: "region_configs"}, hcl.TraverseIndex{Key: cty.NumberIntVal(int64(j))},
+			}
+			for _, name := range objects {
+				if object == name || (object == specsObject && strings.HasSuffix(name, "_specs")) {
+					paths = append(paths, slices.Concat(regionPath, hcl.Traversal{hcl.TraverseAttr{Name: name}, hcl.TraverseAttr{Name: attr}}))
+				}
+			}
+			if object == "" {
+				paths = append(paths, slices.Concat(regionPath, hcl.Traversal{hcl.TraverseAttr{Name: attr}}))
+			}
+		}
+	}
+	return paths
+}
+
+// multilineTuple returns a tuple with an element per line, as the rewritten ignore_changes paths are long.
+func multilineTuple(elems []hclwrite.Tokens) hclwrite.Tokens {
+	newline := &hclwrite.Token{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")}
+	tokens := hclwrite.Tokens{{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")}, newline}
+	for _, elem := range elems {
+		tokens = slices.Concat(tokens, elem, hclwrite.Tokens{{Type: hclsyntax.TokenComma, Bytes: []byte(",")}, newline})
+	}
+	return append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")})
+}
+
+func first(traversal hcl.Traversal) hcl.Traverser {
+	if len(traversal) == 0 {
+		return nil
+	}
+	return traversal[0]
+}
+
+// keyTokens returns a map key, using an identifier when possible and parentheses for expressions.
+func (c *converter) keyTokens(expr hclsyntax.Expression) hclwrite.Tokens {
+	if val, ok := literalValue(expr); ok && val.Type() == cty.String {
+		if hclsyntax.ValidIdentifier(val.AsString()) {
+			return hclwrite.TokensForIdentifier(val.AsString())
+		}
+		return hclwrite.TokensForValue(val)
+	}
+	return slices.Concat(
+		hclwrite.Tokens{{Type: hclsyntax.TokenOParen, Bytes: []byte("(")}},
+		c.tokens(expr),
+		hclwrite.Tokens{{Type: hclsyntax.TokenCParen, Bytes: []byte(")")}},
+	)
+}
+
+// tokens returns the source of an expression so it's kept as it is, e.g. var.instance_size.
+// The output is formatted once all resources are converted.
+func (c *converter) tokens(expr hclsyntax.Expression) hclwrite.Tokens {
+	return c.rawTokens(expr.Range())
+}
+
+func (c *converter) rawTokens(r hcl.Range) hclwrite.Tokens {
+	return hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: c.src[r.Start.Byte:r.End.Byte]}}
+}
+
+func movedBlock(resourceName string) []byte {
+	block := hclwrite.NewBlock("moved", nil)
+	block.Body().SetAttributeTraversal("from", hcl.Traversal{hcl.TraverseRoot{Name: clusterResourceType}, hcl.TraverseAttr{Name: resourceName}})
+	block.Body().SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: advancedClusterResourceType}, hcl.TraverseAttr{Name: resourceName}})
+	// The generated block ends with a newline and the source after the replaced block starts with one.
+	return slices.Concat([]byte("\n"), bytes.TrimSuffix(block.BuildTokens(nil).Bytes(), []byte("\n")))
+}
+
+func objectAttr(name string, value hclwrite.Tokens) hclwrite.ObjectAttrTokens {
+	return hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForIdentifier(name), Value: value}
+}
+
+func sortedAttributes(body *hclsyntax.Body) []*hclsyntax.Attribute {
+	attrs := make([]*hclsyntax.Attribute, 0, len(body.Attributes))
+	for _, attr := range body.Attributes {
+		attrs = append(attrs, attr)
+	}
+	slices.SortFunc(attrs, func(a, b *hclsyntax.Attribute) int {
+		return cmp.Compare(a.SrcRange.Start.Byte, b.SrcRange.Start.Byte)
+	})
+	return attrs
+}
+
+func nestedBlocks(body *hclsyntax.Body, blockType string) []*hclsyntax.Block {
+	var blocks []*hclsyntax.Block
+	for _, block := range body.Blocks {
+		if block.Type == blockType {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+func attrExpr(attrs hclsyntax.Attributes, name string) hclsyntax.Expression {
+	if attr := attrs[name]; attr != nil {
+		return attr.Expr
+	}
+	return nil
+}
+
+// sortByPriority sorts the regions by descending priority, as required by mongodbatlas_advanced_cluster.
+// The order is kept if a priority is not a literal number.
+func sortByPriority(regions []regionConfig) {
+	priorities := make(map[hclsyntax.Expression]int, len(regions))
+	for _, region := range regions {
+		if region.priority == nil {
+			priorities[nil] = defaultPriority
+			continue
+		}
+		priority, err := literalInt(&hclsyntax.Attribute{Expr: region.priority}, 0)
+		if err != nil {
+			return
+		}
+		priorities[region.priority] = priority
+	}
+	slices.SortStableFunc(regions, func(a, b regionConfig) int {
+		return cmp.Compare(priorities[b.priority], priorities[a.priority])
+	})
+}
+
+// hasNodes returns false if the node count is not set or is a literal 0.
+func hasNodes(expr hclsyntax.Expression) bool {
+	if expr == nil {
+		return false
+	}
+	count, err := literalInt(&hclsyntax.Attribute{Expr: expr}, 0)
+	return err != nil || count > 0
+}
+
+// literalInt returns the value of a literal number attribute, or defaultValue if the attribute is not set.
+func literalInt(attr *hclsyntax.Attribute, defaultValue int) (int, error) {
+	if attr == nil {
+		return defaultValue, nil
+	}
+	val, ok := literalValue(attr.Expr)
+	if !ok {
+		return 0, fmt.Errorf("%s must be a literal number", attr.Name)
+	}
+	var result int
+	if err := gocty.FromCtyValue(val, &result); err != nil {
+		return 0, fmt.Errorf("%s must be a literal number: %w", attr.Name, err)
+	}
+	return result, nil
+}
+
+func isLiteralString(expr hclsyntax.Expression, expected string) bool {
+	val, ok := literalValue(expr)
+	return ok && val.Type() == cty.String && val.AsString() == expected
+}
+
+// literalValue returns the value of an expression without references or function calls.
+func literalValue(expr hclsyntax.Expression) (cty.Value, bool) {
+	if len(expr.Variables()) > 0 {
+		return cty.NilVal, false
+	}
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() || !val.IsWhollyKnown() || val.IsNull() {
+		return cty.NilVal, false
+	}
+	return val, true
+}
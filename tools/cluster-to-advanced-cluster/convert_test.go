@@ -0,0 +1,142 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sebdah/goldie/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertFile(t *testing.T) {
+	testCases := map[string]struct {
+		expectedWarnings []string
+		expectedCount    int
+	}{
+		"basic": {
+			expectedCount: 1,
+			expectedWarnings: []string{
+				"mongodbatlas_cluster.this: advanced_configuration.default_read_concern is dropped, it's removed in mongodbatlas_advanced_cluster",
+			},
+		},
+		"sharded_autoscaling": {
+			expectedCount: 1,
+			expectedWarnings: []string{
+				"mongodbatlas_cluster.sharded: lifecycle.ignore_changes reference replication_specs[0].num_shards is dropped, it has no equivalent in mongodbatlas_advanced_cluster",
+			},
+		},
+		"tenant": {
+			expectedCount: 2,
+			expectedWarnings: []string{
+				"mongodbatlas_cluster.single_region: backup_enabled is dropped, legacy backup is not supported, use cloud_backup to enable Cloud Backup",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			filename := filepath.Join("testdata", name+".in.tf")
+			src, err := os.ReadFile(filename)
+			require.NoError(t, err)
+			out, converted, warnings, err := convertFile(t.Context(), src, filename)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCount, converted)
+			assert.Equal(t, tc.expectedWarnings, warnings)
+			g := goldie.New(t, goldie.WithNameSuffix(".golden.tf"))
+			g.Assert(t, name, out)
+		})
+	}
+}
+
+func TestConvertFileErrors(t *testing.T) {
+	testCases := map[string]struct {
+		src           string
+		expectedError string
+	}{
+		"dynamic block": {
+			src:           "dynamic.in.tf",
+			expectedError: `mongodbatlas_cluster.this: dynamic blocks are not supported, convert dynamic "replication_specs" manually`,
+		},
+		"num_shards is not a literal": {
+			src: `
+				resource "mongodbatlas_cluster" "this" {
+					project_id                  = var.project_id
+					name                        = "test"
+					provider_name               = "AWS"
+					provider_instance_size_name = "M10"
+					replication_specs {
+						num_shards = var.num_shards
+						regions_config {
+							region_name = "US_EAST_1"
+						}
+					}
+				}
+			`,
+			expectedError: "mongodbatlas_cluster.this: num_shards must be a literal number",
+		},
+		"missing region": {
+			src: `
+				resource "mongodbatlas_cluster" "this" {
+					project_id                  = var.project_id
+					name                        = "test"
+					provider_name               = "AWS"
+					provider_instance_size_name = "M10"
+				}
+			`,
+			expectedError: "mongodbatlas_cluster.this: provider_region_name is required when there are no replication_specs blocks",
+		},
+		"unsupported attribute": {
+			src: `
+				resource "mongodbatlas_cluster" "this" {
+					project_id                  = var.project_id
+					name                        = "test"
+					provider_name               = "AWS"
+					provider_region_name        = "US_EAST_1"
+					provider_instance_size_name = "M10"
+					unknown_attribute           = true
+				}
+			`,
+			expectedError: "mongodbatlas_cluster.this: attribute unknown_attribute is not supported",
+		},
+		"invalid generated type": {
+			src: `
+				resource "mongodbatlas_cluster" "this" {
+					project_id                  = var.project_id
+					name                        = "test"
+					provider_name               = "AWS"
+					provider_region_name        = "US_EAST_1"
+					provider_instance_size_name = "M10"
+					paused                      = "not a bool"
+				}
+			`,
+			expectedError: "mongodbatlas_cluster.this: generated configuration is not valid: paused: a bool is required",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			src := []byte(tc.src)
+			if filepath.Ext(tc.src) == ".tf" {
+				var err error
+				src, err = os.ReadFile(filepath.Join("testdata", tc.src))
+				require.NoError(t, err)
+			}
+			_, _, _, err := convertFile(t.Context(), src, "test.tf")
+			require.Error(t, err)
+			assert.Equal(t, tc.expectedError, err.Error())
+		})
+	}
+}
+
+func TestConvertFileWithoutClusters(t *testing.T) {
+	src := []byte(`resource "mongodbatlas_project" "this" {
+  name   = "test"
+  org_id = var.org_id
+}
+`)
+	out, converted, warnings, err := convertFile(t.Context(), src, "test.tf")
+	require.NoError(t, err)
+	assert.Zero(t, converted)
+	assert.Empty(t, warnings)
+	assert.Equal(t, string(src), string(out))
+}
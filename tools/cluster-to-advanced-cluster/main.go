@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	writeFlag := flag.Bool("write", false, "Write the converted configuration to the source files instead of stdout")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: cluster-to-advanced-cluster [--write] <file or directory>...")
+		fmt.Fprintln(os.Stderr, "Converts mongodbatlas_cluster resources to mongodbatlas_advanced_cluster resources with moved blocks.")
+		fmt.Fprintln(os.Stderr, "Only HCL configuration files (.tf) are converted, the Terraform state is not read.")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	files, err := collectTerraformFiles(flag.Args())
+	if err != nil {
+		log.Fatalf("failed to collect Terraform files: %v", err)
+	}
+	ctx := context.Background()
+	total := 0
+	for _, f := range files {
+		src, err := os.ReadFile(f)
+		if err != nil {
+			log.Fatalf("failed to read %s: %v", f, err)
+		}
+		out, converted, warnings, err := convertFile(ctx, src, f)
+		if err != nil {
+			log.Fatalf("failed to convert %s: %v", f, err)
+		}
+		for _, warning := range warnings {
+			fmt.Fprintf(os.Stderr, "Warning: %s: %s\n", f, warning)
+		}
+		if converted == 0 {
+			continue
+		}
+		total += converted
+		if !*writeFlag {
+			fmt.Printf("# %s\n%s\n", f, out)
+			continue
+		}
+		if err := os.WriteFile(f, out, 0o600); err != nil {
+			log.Fatalf("failed to write %s: %v", f, err)
+		}
+		fmt.Fprintf(os.Stderr, "Converted %d cluster(s): %s\n", converted, f)
+	}
+	if total == 0 {
+		fmt.Fprintln(os.Stderr, "No mongodbatlas_cluster resources found.")
+	}
+}
+
+func collectTerraformFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, p := range paths {
+		err := filepath.WalkDir(p, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if path != p && strings.HasPrefix(d.Name(), ".") { // Skip .terraform and other hidden directories
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if strings.HasSuffix(path, ".tf") {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,56 @@
+resource "mongodbatlas_advanced_cluster" "this" {
+  project_id             = var.project_id
+  name                   = var.cluster_name
+  cluster_type           = "REPLICASET"
+  mongo_db_major_version = var.mongo_db_major_version
+  backup_enabled         = true
+  replication_specs = [{
+    region_configs = [{
+      provider_name = "AWS"
+      region_name   = "US_WEST_1"
+      priority      = 7
+      electable_specs = {
+        instance_size = var.instance_size
+        node_count    = 2
+        disk_size_gb  = 30
+      }
+      }, {
+      provider_name = "AWS"
+      region_name   = "US_EAST_1"
+      priority      = 6
+      electable_specs = {
+        instance_size = var.instance_size
+        node_count    = 3
+        disk_size_gb  = 30
+      }
+      read_only_specs = {
+        instance_size = var.instance_size
+        node_count    = 1
+        disk_size_gb  = 30
+      }
+      analytics_specs = {
+        instance_size = var.instance_size
+        node_count    = 1
+        disk_size_gb  = 30
+      }
+    }]
+  }]
+  advanced_configuration = {
+    javascript_enabled = true
+  }
+  tags = {
+    ManagedBy = "Terraform"
+    Example   = "examples-migrate_cluster_to_advanced_cluster-basic"
+  }
+
+  # Generated by cluster-to-advanced-cluster, update the references to mongodbatlas_cluster.this
+}
+
+moved {
+  from = mongodbatlas_cluster.this
+  to   = mongodbatlas_advanced_cluster.this
+}
+
+output "cluster_name" {
+  value = mongodbatlas_cluster.this.name
+}
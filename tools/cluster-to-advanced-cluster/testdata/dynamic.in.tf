@@ -0,0 +1,13 @@
+resource "mongodbatlas_cluster" "this" {
+  project_id                  = var.project_id
+  name                        = var.cluster_name
+  provider_name               = "AWS"
+  provider_instance_size_name = "M10"
+
+  dynamic "replication_specs" {
+    for_each = var.replication_specs
+    content {
+      num_shards = replication_specs.value.num_shards
+    }
+  }
+}
@@ -0,0 +1,80 @@
+resource "mongodbatlas_advanced_cluster" "sharded" {
+  count                          = var.enabled ? 1 : 0
+  project_id                     = mongodbatlas_project.this.id
+  name                           = "sharded"
+  cluster_type                   = "GEOSHARDED"
+  termination_protection_enabled = false
+  replication_specs = [{
+    zone_name = "Zone 1"
+    region_configs = [{
+      provider_name = "GCP"
+      region_name   = "CENTRAL_US"
+      priority      = 7
+      electable_specs = {
+        instance_size = "M30"
+        node_count    = 3
+      }
+      auto_scaling = {
+        disk_gb_enabled           = true
+        compute_enabled           = true
+        compute_max_instance_size = "M40"
+      }
+    }]
+    }, {
+    zone_name = "Zone 1"
+    region_configs = [{
+      provider_name = "GCP"
+      region_name   = "CENTRAL_US"
+      priority      = 7
+      electable_specs = {
+        instance_size = "M30"
+        node_count    = 3
+      }
+      auto_scaling = {
+        disk_gb_enabled           = true
+        compute_enabled           = true
+        compute_max_instance_size = "M40"
+      }
+    }]
+    }, {
+    zone_name = "Zone 2"
+    region_configs = [{
+      provider_name = "GCP"
+      region_name   = "WESTERN_EUROPE"
+      priority      = 7
+      electable_specs = {
+        instance_size = "M30"
+        node_count    = 3
+      }
+      auto_scaling = {
+        disk_gb_enabled           = true
+        compute_enabled           = true
+        compute_max_instance_size = "M40"
+      }
+    }]
+  }]
+  labels = {
+    (var.label_key) = "label"
+  }
+  timeouts = {
+    create = "2h"
+  }
+
+  lifecycle {
+    prevent_destroy = true
+    ignore_changes = [
+      replication_specs[0].region_configs[0].electable_specs.instance_size,
+      replication_specs[1].region_configs[0].electable_specs.instance_size,
+      replication_specs[2].region_configs[0].electable_specs.instance_size,
+      backup_enabled,
+      advanced_configuration.oplog_size_mb,
+    ]
+  }
+
+  # Generated by cluster-to-advanced-cluster, update the references to mongodbatlas_cluster.sharded
+}
+
+moved {
+  from = mongodbatlas_cluster.sharded
+  to   = mongodbatlas_advanced_cluster.sharded
+}
@@ -0,0 +1,46 @@
+resource "mongodbatlas_cluster" "sharded" {
+  count = var.enabled ? 1 : 0
+
+  project_id                                      = mongodbatlas_project.this.id
+  name                                            = "sharded"
+  cluster_type                                    = "GEOSHARDED"
+  provider_name                                   = "GCP"
+  provider_instance_size_name                     = "M30"
+  auto_scaling_disk_gb_enabled                    = true
+  auto_scaling_compute_enabled                    = true
+  provider_auto_scaling_compute_max_instance_size = "M40"
+  termination_protection_enabled                  = false
+
+  replication_specs {
+    num_shards = 2
+    zone_name  = "Zone 1"
+    regions_config {
+      region_name     = "CENTRAL_US"
+      electable_nodes = 3
+      priority        = 7
+    }
+  }
+  replication_specs {
+    num_shards = 1
+    zone_name  = "Zone 2"
+    regions_config {
+      region_name     = "WESTERN_EUROPE"
+      electable_nodes = 3
+      priority        = 7
+      read_only_nodes = 0
+    }
+  }
+  labels {
+    key   = var.label_key
+    value = "label"
+  }
+
+  timeouts {
+    create = "2h"
+  }
+
+  lifecycle {
+    prevent_destroy = true
+    ignore_changes  = [provider_instance_size_name, cloud_backup, advanced_configuration[0].oplog_size_mb, replication_specs[0].num_shards]
+  }
+}
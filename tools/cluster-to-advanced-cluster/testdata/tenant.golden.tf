@@ -0,0 +1,49 @@
+resource "mongodbatlas_advanced_cluster" "free" {
+  project_id   = var.project_id
+  name         = "free"
+  cluster_type = "REPLICASET"
+  replication_specs = [{
+    region_configs = [{
+      provider_name         = "TENANT"
+      backing_provider_name = "AWS"
+      region_name           = "US_EAST_1"
+      priority              = 7
+      electable_specs = {
+        instance_size = "M0"
+      }
+    }]
+  }]
+
+  # Generated by cluster-to-advanced-cluster, update the references to mongodbatlas_cluster.free
+}
+
+moved {
+  from = mongodbatlas_cluster.free
+  to   = mongodbatlas_advanced_cluster.free
+}
+
+resource "mongodbatlas_advanced_cluster" "single_region" {
+  project_id   = var.project_id
+  name         = "single-region"
+  cluster_type = "REPLICASET"
+  replication_specs = [{
+    region_configs = [{
+      provider_name = "AWS"
+      region_name   = "EU_WEST_1"
+      priority      = 7
+      electable_specs = {
+        instance_size   = "M10"
+        node_count      = 5
+        disk_iops       = 3000
+        ebs_volume_type = "STANDARD"
+      }
+    }]
+  }]
+
+  # Generated by cluster-to-advanced-cluster, update the references to mongodbatlas_cluster.single_region
+}
+
+moved {
+  from = mongodbatlas_cluster.single_region
+  to   = mongodbatlas_advanced_cluster.single_region
+}
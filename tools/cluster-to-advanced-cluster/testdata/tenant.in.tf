@@ -0,0 +1,20 @@
+resource "mongodbatlas_cluster" "free" {
+  project_id                  = var.project_id
+  name                        = "free"
+  provider_name               = "TENANT"
+  backing_provider_name       = "AWS"
+  provider_region_name        = "US_EAST_1"
+  provider_instance_size_name = "M0"
+}
+
+resource "mongodbatlas_cluster" "single_region" {
+  project_id                  = var.project_id
+  name                        = "single-region"
+  provider_name               = "AWS"
+  provider_region_name        = "EU_WEST_1"
+  provider_instance_size_name = "M10"
+  provider_disk_iops          = 3000
+  provider_volume_type        = "STANDARD"
+  replication_factor          = 5
+  backup_enabled              = false
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/advancedcluster"
+)
+
+// validateAdvancedCluster checks a generated mongodbatlas_advanced_cluster resource against the resource schema and model:
+// the configuration is converted to advancedcluster.TFModel and back, and both values must be equal so no attribute is lost.
+// Values that depend on references or functions, e.g. var.instance_size, are unknown so only their type and position are checked.
+func validateAdvancedCluster(ctx context.Context, src []byte) error {
+	file, diags := hclsyntax.ParseConfig(src, "generated.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		return diags
+	}
+	block := file.Body.(*hclsyntax.Body).Blocks[0]
+	var schemaResp resource.SchemaResponse
+	advancedcluster.Resource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		return errors.New("unexpected schema type")
+	}
+	vals := make(map[string]cty.Value)
+	for name, attr := range block.Body.Attributes {
+		if slices.Contains(metaArguments, name) {
+			continue
+		}
+		if _, ok := objType.AttributeTypes[name]; !ok {
+			return fmt.Errorf("attribute %s is not supported", name)
+		}
+		vals[name] = exprValue(attr.Expr)
+	}
+	val, err := convert.Convert(cty.ObjectVal(vals), ctyType(objType))
+	if err != nil {
+		return pathError(err)
+	}
+	raw, err := tftypesValue(val, objType)
+	if err != nil {
+		return err
+	}
+	var model advancedcluster.TFModel
+	config := tfsdk.Config{Raw: raw, Schema: schemaResp.Schema}
+	if d := config.Get(ctx, &model); d.HasError() {
+		return diagsError(d)
+	}
+	state := tfsdk.State{Raw: tftypes.NewValue(objType, nil), Schema: schemaResp.Schema}
+	if d := state.Set(ctx, &model); d.HasError() {
+		return diagsError(d)
+	}
+	if !state.Raw.Equal(raw) {
+		diffs, _ := raw.Diff(state.Raw)
+		paths := make([]string, len(diffs))
+		for i := range diffs {
+			paths[i] = diffs[i].Path.String()
+		}
+		return fmt.Errorf("attributes are not preserved by the model: %s", strings.Join(paths, ", "))
+	}
+	d := new(diag.Diagnostics)
+	for i, spec := range advancedcluster.TFModelList[advancedcluster.TFReplicationSpecsModel](ctx, d, model.ReplicationSpecs) {
+		validateReq := validator.ListRequest{
+			Path:        path.Root("replication_specs").AtListIndex(i).AtName("region_configs"),
+			ConfigValue: spec.RegionConfigs,
+		}
+		var validateResp validator.ListResponse
+		advancedcluster.RegionSpecPriorityOrderDecreasingValidator{}.ValidateList(ctx, validateReq, &validateResp)
+		d.Append(validateResp.Diagnostics...)
+	}
+	if d.HasError() {
+		return diagsError(*d)
+	}
+	return nil
+}
+
+// exprValue evaluates an expression with its references as unknown values. Expressions that can't be evaluated,
+// e.g. function calls, are unknown.
+func exprValue(expr hclsyntax.Expression) cty.Value {
+	evalCtx := &hcl.EvalContext{Variables: make(map[string]cty.Value)}
+	for _, traversal := range expr.Variables() {
+		evalCtx.Variables[traversal.RootName()] = cty.DynamicVal
+	}
+	val, diags := expr.Value(evalCtx)
+	if diags.HasErrors() {
+		return cty.DynamicVal
+	}
+	return val
+}
+
+// ctyType returns the cty type of a schema type, with all object attributes optional as they're validated by the model.
+func ctyType(typ tftypes.Type) cty.Type {
+	switch t := typ.(type) {
+	case tftypes.Object:
+		attrTypes := make(map[string]cty.Type, len(t.AttributeTypes))
+		optional := make([]string, 0, len(t.AttributeTypes))
+		for name, attrType := range t.AttributeTypes {
+			attrTypes[name] = ctyType(attrType)
+			optional = append(optional, name)
+		}
+		return cty.ObjectWithOptionalAttrs(attrTypes, optional)
+	case tftypes.List:
+		return cty.List(ctyType(t.ElementType))
+	case tftypes.Set:
+		return cty.Set(ctyType(t.ElementType))
+	case tftypes.Map:
+		return cty.Map(ctyType(t.ElementType))
+	}
+	switch {
+	case typ.Is(tftypes.String):
+		return cty.String
+	case typ.Is(tftypes.Number):
+		return cty.Number
+	case typ.Is(tftypes.Bool):
+		return cty.Bool
+	default:
+		return cty.DynamicPseudoType
+	}
+}
+
+// tftypesValue converts a cty value that conforms to ctyType(typ) to a tftypes value.
+func tftypesValue(val cty.Value, typ tftypes.Type) (tftypes.Value, error) {
+	if !val.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+	if val.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	switch t := typ.(type) {
+	case tftypes.Object:
+		attrs := make(map[string]tftypes.Value, len(t.AttributeTypes))
+		for name, attrType := range t.AttributeTypes {
+			attr, err := tftypesValue(val.GetAttr(name), attrType)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			attrs[name] = attr
+		}
+		return tftypes.NewValue(typ, attrs), nil
+	case tftypes.List:
+		return tftypesCollection(val, typ, t.ElementType)
+	case tftypes.Set:
+		return tftypesCollection(val, typ, t.ElementType)
+	case tftypes.Map:
+		elems := make(map[string]tftypes.Value)
+		for key, elem := range val.AsValueMap() {
+			elemVal, err := tftypesValue(elem, t.ElementType)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			elems[key] = elemVal
+		}
+		return tftypes.NewValue(typ, elems), nil
+	}
+	switch {
+	case typ.Is(tftypes.String):
+		return tftypes.NewValue(typ, val.AsString()), nil
+	case typ.Is(tftypes.Number):
+		return tftypes.NewValue(typ, val.AsBigFloat()), nil
+	case typ.Is(tftypes.Bool):
+		return tftypes.NewValue(typ, val.True()), nil
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported type %s", typ)
+	}
+}
+
+func tftypesCollection(val cty.Value, typ, elemType tftypes.Type) (tftypes.Value, error) {
+	var elems []tftypes.Value
+	for _, elem := range val.AsValueSlice() {
+		elemVal, err := tftypesValue(elem, elemType)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		elems = append(elems, elemVal)
+	}
+	return tftypes.NewValue(typ, elems), nil
+}
+
+func pathError(err error) error {
+	var pathErr cty.PathError
+	if !errors.As(err, &pathErr) {
+		return err
+	}
+	var sb strings.Builder
+	for _, step := range pathErr.Path {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(s.Name)
+		case cty.IndexStep:
+			if s.Key.Type() == cty.Number {
+				fmt.Fprintf(&sb, "[%s]", s.Key.AsBigFloat().String())
+			}
+		}
+	}
+	return fmt.Errorf("%s: %w", sb.String(), err)
+}
+
+func diagsError(d diag.Diagnostics) error {
+	errs := make([]error, 0, d.ErrorsCount())
+	for _, e := range d.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", e.Summary(), e.Detail()))
+	}
+	return errors.Join(errs...)
+}
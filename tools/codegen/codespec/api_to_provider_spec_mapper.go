@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
@@ -17,6 +18,8 @@ import (
 
 const InternalResourceSuffix = "_api"
 
+var pathParamRegex = regexp.MustCompile(`\{[^}]+\}`)
+
 func ToCodeSpecModel(atlasAdminAPISpecFilePath, configPath string, resourceName *string, resourceTier *ResourceTier) (*Model, error) {
 	apiSpec, err := openapi.ParseAtlasAdminAPI(atlasAdminAPISpecFilePath)
 	if err != nil {
@@ -202,13 +205,18 @@ func apiSpecResourceToCodeSpecModel(oasResource APISpecResource, resourceConfig
 		moveState = &MoveState{SourceResources: resourceConfig.MoveState.SourceResources}
 	}
 
+	if err := validateOperationLock(resourceConfig.OperationLock, &operations); err != nil {
+		return nil, fmt.Errorf("resource %s has invalid operation_lock in config file: %w", name, err)
+	}
+
 	resource := &Resource{
-		Name:         name,
-		PackageName:  strings.ReplaceAll(name, "_", ""),
-		Schema:       schema,
-		MoveState:    moveState,
-		Operations:   operations,
-		IDAttributes: resourceConfig.IDAttributes,
+		Name:          name,
+		PackageName:   strings.ReplaceAll(name, "_", ""),
+		Schema:        schema,
+		MoveState:     moveState,
+		Operations:    operations,
+		OperationLock: resourceConfig.OperationLock,
+		IDAttributes:  resourceConfig.IDAttributes,
 	}
 
 	if err := ApplyTransformationsToResource(resourceConfig, resource); err != nil {
@@ -218,6 +226,18 @@ func apiSpecResourceToCodeSpecModel(oasResource APISpecResource, resourceConfig
 	return resource, nil
 }
 
+// validateOperationLock checks that the path params used in the operation lock key are available in create, update and delete operations.
+func validateOperationLock(operationLock string, operations *APIOperations) error {
+	for _, placeholder := range pathParamRegex.FindAllString(operationLock, -1) {
+		for _, op := range []*APIOperation{operations.Create, operations.Update, operations.Delete} {
+			if op != nil && !strings.Contains(op.Path, placeholder) {
+				return fmt.Errorf("path param %s is not in path %s", placeholder, op.Path)
+			}
+		}
+	}
+	return nil
+}
+
 func getLatestVersionFromAPISpec(readOp *high.Operation) string {
 	okResponse, ok := readOp.Responses.Codes.Get(OASResponseCodeOK)
 	if !ok {
@@ -248,6 +268,7 @@ func operationConfigToModel(opConfig *config.APIOperation) *APIOperation {
 		HTTPMethod:        opConfig.Method,
 		Path:              opConfig.Path,
 		Wait:              waitConfigToModel(opConfig.Wait),
+		Retry:             retryConfigToModel(opConfig.Retry),
 		StaticRequestBody: opConfig.StaticRequestBody,
 		ResetsToDefaults:  opConfig.ResetsToDefaults,
 	}
@@ -267,6 +288,20 @@ func waitConfigToModel(waitConfig *config.Wait) *Wait {
 	}
 }
 
+func retryConfigToModel(retryConfig *config.Retry) *Retry {
+	if retryConfig == nil {
+		return nil
+	}
+	return &Retry{
+		ErrorCodes:         retryConfig.ErrorCodes,
+		StatusCodes:        retryConfig.StatusCodes,
+		MaxAttempts:        retryConfig.MaxAttempts,
+		TimeoutSeconds:     retryConfig.TimeoutSeconds,
+		MinTimeoutSeconds:  retryConfig.MinTimeoutSeconds,
+		AllowNonIdempotent: retryConfig.AllowNonIdempotent,
+	}
+}
+
 func pathParamsToAttributes(createOp *high.Operation) Attributes {
 	pathParams := createOp.Parameters
 
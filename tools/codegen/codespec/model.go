@@ -39,13 +39,14 @@ type Model struct {
 }
 
 type Resource struct {
-	Schema       *Schema       `yaml:"schema,omitempty"`
-	Operations   APIOperations `yaml:"operations"`
-	MoveState    *MoveState    `yaml:"move_state,omitempty"`
-	DataSources  *DataSources  `yaml:"data_sources,omitempty"`
-	Name         string        `yaml:"name"`
-	PackageName  string        `yaml:"packageName"`
-	IDAttributes []string      `yaml:"id_attributes,omitempty"`
+	Schema        *Schema       `yaml:"schema,omitempty"`
+	Operations    APIOperations `yaml:"operations"`
+	MoveState     *MoveState    `yaml:"move_state,omitempty"`
+	DataSources   *DataSources  `yaml:"data_sources,omitempty"`
+	Name          string        `yaml:"name"`
+	PackageName   string        `yaml:"packageName"`
+	OperationLock string        `yaml:"operation_lock,omitempty"`
+	IDAttributes  []string      `yaml:"id_attributes,omitempty"`
 }
 
 // DataSources holds the data source configuration within a resource.
@@ -68,6 +69,7 @@ type APIOperations struct {
 
 type APIOperation struct {
 	Wait              *Wait  `yaml:"wait,omitempty"`
+	Retry             *Retry `yaml:"retry,omitempty"`
 	HTTPMethod        string `yaml:"http_method"`
 	Path              string `yaml:"path"`
 	StaticRequestBody string `yaml:"static_request_body,omitempty"`
@@ -83,6 +85,15 @@ type Wait struct {
 	DelaySeconds      int      `yaml:"delay_seconds"`
 }
 
+type Retry struct {
+	ErrorCodes         []string `yaml:"error_codes,omitempty"`
+	StatusCodes        []int    `yaml:"status_codes,omitempty"`
+	MaxAttempts        int      `yaml:"max_attempts,omitempty"`
+	TimeoutSeconds     int      `yaml:"timeout_seconds"`
+	MinTimeoutSeconds  int      `yaml:"min_timeout_seconds"`
+	AllowNonIdempotent bool     `yaml:"allow_non_idempotent,omitempty"`
+}
+
 type MoveState struct {
 	SourceResources []string `yaml:"source_resources"`
 }
@@ -252,7 +263,6 @@ type CustomDefault struct {
 type CustomTypePackage string
 
 const (
-	JSONTypesPkg   CustomTypePackage = "jsontypes"
 	CustomTypesPkg CustomTypePackage = "customtypes"
 )
 
@@ -264,9 +274,9 @@ type CustomType struct {
 }
 
 var CustomTypeJSONVar = CustomType{
-	Packages: []CustomTypePackage{JSONTypesPkg},
-	Model:    "jsontypes.Normalized",
-	Schema:   "jsontypes.NormalizedType{}",
+	Packages: []CustomTypePackage{CustomTypesPkg},
+	Model:    "customtypes.JSONValue",
+	Schema:   "customtypes.JSONType{}",
 }
 
 func NewCustomObjectType(name string) *CustomType {
@@ -278,18 +288,10 @@ func NewCustomObjectType(name string) *CustomType {
 	}
 }
 
-func customCollectionPackages(elemType ElemType) []CustomTypePackage {
-	packages := []CustomTypePackage{CustomTypesPkg}
-	if elemType == CustomTypeJSON {
-		packages = append(packages, JSONTypesPkg)
-	}
-	return packages
-}
-
 func NewCustomListType(elemType ElemType) *CustomType {
 	elemTypeStr := ElementTypeToModelString[elemType]
 	return &CustomType{
-		Packages: customCollectionPackages(elemType),
+		Packages: []CustomTypePackage{CustomTypesPkg},
 		Model:    fmt.Sprintf("customtypes.ListValue[%s]", elemTypeStr),
 		Schema:   fmt.Sprintf("customtypes.NewListType[%s](ctx)", elemTypeStr),
 	}
@@ -307,7 +309,7 @@ func NewCustomNestedListType(name string) *CustomType {
 func NewCustomSetType(elemType ElemType) *CustomType {
 	elemTypeStr := ElementTypeToModelString[elemType]
 	return &CustomType{
-		Packages: customCollectionPackages(elemType),
+		Packages: []CustomTypePackage{CustomTypesPkg},
 		Model:    fmt.Sprintf("customtypes.SetValue[%s]", elemTypeStr),
 		Schema:   fmt.Sprintf("customtypes.NewSetType[%s](ctx)", elemTypeStr),
 	}
@@ -325,7 +327,7 @@ func NewCustomNestedSetType(name string) *CustomType {
 func NewCustomMapType(elemType ElemType) *CustomType {
 	elemTypeStr := ElementTypeToModelString[elemType]
 	return &CustomType{
-		Packages: customCollectionPackages(elemType),
+		Packages: []CustomTypePackage{CustomTypesPkg},
 		Model:    fmt.Sprintf("customtypes.MapValue[%s]", elemTypeStr),
 		Schema:   fmt.Sprintf("customtypes.NewMapType[%s](ctx)", elemTypeStr),
 	}
@@ -14,15 +14,15 @@ func TestCustomCollectionPackages(t *testing.T) {
 	}{
 		"List of JSON": {
 			customType: codespec.NewCustomListType(codespec.CustomTypeJSON),
-			packages:   []codespec.CustomTypePackage{codespec.CustomTypesPkg, codespec.JSONTypesPkg},
+			packages:   []codespec.CustomTypePackage{codespec.CustomTypesPkg},
 		},
 		"Map of JSON": {
 			customType: codespec.NewCustomMapType(codespec.CustomTypeJSON),
-			packages:   []codespec.CustomTypePackage{codespec.CustomTypesPkg, codespec.JSONTypesPkg},
+			packages:   []codespec.CustomTypePackage{codespec.CustomTypesPkg},
 		},
 		"Set of JSON": {
 			customType: codespec.NewCustomSetType(codespec.CustomTypeJSON),
-			packages:   []codespec.CustomTypePackage{codespec.CustomTypesPkg, codespec.JSONTypesPkg},
+			packages:   []codespec.CustomTypePackage{codespec.CustomTypesPkg},
 		},
 		"List of strings": {
 			customType: codespec.NewCustomListType(codespec.String),
@@ -14,6 +14,7 @@ type Resource struct {
 	DeprecationMessage *string       `yaml:"deprecation_message"`
 	DataSources        *DataSources  `yaml:"datasources"`    // when defined, data source(s) are generated with independent schema options
 	VersionHeader      string        `yaml:"version_header"` // when not defined latest version defined in API Spec of the resource is used
+	OperationLock      string        `yaml:"operation_lock"` // serializes create, update and delete with other resources using the same key, e.g. "project:{groupId}:networking"
 	SchemaOptions      SchemaOptions `yaml:"schema"`
 }
 
@@ -26,6 +27,7 @@ type DataSources struct {
 
 type APIOperation struct {
 	Wait              *Wait  `yaml:"wait"`
+	Retry             *Retry `yaml:"retry"`
 	Path              string `yaml:"path"`
 	Method            string `yaml:"method"`
 	StaticRequestBody string `yaml:"static_request_body"`
@@ -42,6 +44,17 @@ type Wait struct {
 	DelaySeconds      int      `yaml:"delay_seconds"`
 }
 
+// Retry defines when a failed API call is retried, e.g. transient 409 errors while another change is being applied.
+// The call is retried with exponential backoff until it succeeds, the attempts are exhausted or the timeout is reached.
+type Retry struct {
+	ErrorCodes         []string `yaml:"error_codes"`  // Atlas error codes as found in API response body, e.g. "CANNOT_UPDATE_CLUSTER"
+	StatusCodes        []int    `yaml:"status_codes"` // HTTP status codes retried regardless of the error code, e.g. 500
+	MaxAttempts        int      `yaml:"max_attempts"` // including the first call, no limit if not defined so only the timeout applies
+	TimeoutSeconds     int      `yaml:"timeout_seconds"`
+	MinTimeoutSeconds  int      `yaml:"min_timeout_seconds"`  // backoff before the first retry, doubled in each retry
+	AllowNonIdempotent bool     `yaml:"allow_non_idempotent"` // POST and PATCH calls are only retried if true, as repeating them could apply the change twice
+}
+
 type MoveState struct {
 	SourceResources []string `yaml:"source_resources"`
 }
@@ -36,6 +36,7 @@ type ResourceFileInputs struct {
 	MoveState     *MoveState
 	PackageName   string
 	ResourceName  string
+	OperationLock string
 	IDAttributes  []string
 	APIOperations APIOperations
 }
@@ -48,8 +49,14 @@ type APIOperations struct {
 	Read          Operation
 }
 
+// HasRetry returns true if any operation defines a retry policy.
+func (a APIOperations) HasRetry() bool {
+	return a.Create.Retry != nil || (a.Update != nil && a.Update.Retry != nil) || (a.Delete != nil && a.Delete.Retry != nil)
+}
+
 type Operation struct {
 	Wait              *Wait
+	Retry             *Retry
 	Path              string
 	HTTPMethod        string
 	StaticRequestBody string
@@ -65,6 +72,16 @@ type Wait struct {
 	MinTimeoutSeconds int
 	DelaySeconds      int
 }
+
+type Retry struct {
+	ErrorCodes         []string
+	StatusCodes        []int
+	MaxAttempts        int
+	TimeoutSeconds     int
+	MinTimeoutSeconds  int
+	AllowNonIdempotent bool
+}
+
 type Param struct {
 	PascalCaseName string
 	CamelCaseName  string
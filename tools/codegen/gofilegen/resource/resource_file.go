@@ -34,8 +34,9 @@ func GenerateGoCode(input *codespec.Resource) ([]byte, error) {
 			Read:          *toCodeTemplateOpModel(input.Operations.Read),
 			Delete:        toCodeTemplateOpModel(input.Operations.Delete),
 		},
-		MoveState:    toCodeTemplateMoveStateModel(input.MoveState),
-		IDAttributes: idAttrs,
+		MoveState:     toCodeTemplateMoveStateModel(input.MoveState),
+		OperationLock: input.OperationLock,
+		IDAttributes:  idAttrs,
 	}
 	result := codetemplate.ApplyResourceFileTemplate(&tmplInputs)
 
@@ -62,6 +63,7 @@ func toCodeTemplateOpModel(op *codespec.APIOperation) *codetemplate.Operation {
 		HTTPMethod:        op.HTTPMethod,
 		PathParams:        GetPathParams(op.Path),
 		Wait:              getWaitValues(op.Wait),
+		Retry:             getRetryValues(op.Retry),
 		StaticRequestBody: op.StaticRequestBody,
 		ResetsToDefaults:  op.ResetsToDefaults,
 	}
@@ -81,6 +83,20 @@ func getWaitValues(wait *codespec.Wait) *codetemplate.Wait {
 	}
 }
 
+func getRetryValues(retry *codespec.Retry) *codetemplate.Retry {
+	if retry == nil {
+		return nil
+	}
+	return &codetemplate.Retry{
+		ErrorCodes:         retry.ErrorCodes,
+		StatusCodes:        retry.StatusCodes,
+		MaxAttempts:        retry.MaxAttempts,
+		TimeoutSeconds:     retry.TimeoutSeconds,
+		MinTimeoutSeconds:  retry.MinTimeoutSeconds,
+		AllowNonIdempotent: retry.AllowNonIdempotent,
+	}
+}
+
 // GetPathParams extracts path parameters from a URL path and returns them as Param structs.
 // This can eventually be explicitly defined in the intermediate model if additional information is required.
 func GetPathParams(s string) []codetemplate.Param {
@@ -145,6 +145,98 @@ func TestResourceGenerationFromCodeSpec(t *testing.T) {
 			},
 			goldenFileName: "wait-configuration",
 		},
+		"Defining retry configuration in create update and delete": {
+			inputModel: codespec.Resource{
+				Name:        "test_name",
+				PackageName: "testname",
+				Schema: &codespec.Schema{
+					Attributes: codespec.Attributes{
+						{
+							TFSchemaName: "project_id",
+							TFModelName:  "ProjectId",
+						},
+					},
+				},
+				Operations: codespec.APIOperations{
+					Create: &codespec.APIOperation{
+						HTTPMethod: "POST",
+						Path:       "/api/v1/testname/{projectId}",
+						Retry: &codespec.Retry{
+							ErrorCodes:         []string{"CANNOT_CREATE_TEST_NAME"},
+							StatusCodes:        []int{500, 503},
+							MaxAttempts:        5,
+							TimeoutSeconds:     300,
+							MinTimeoutSeconds:  10,
+							AllowNonIdempotent: true,
+						},
+					},
+					Update: &codespec.APIOperation{
+						HTTPMethod: "PATCH",
+						Path:       "/api/v1/testname/{projectId}",
+						Retry: &codespec.Retry{
+							ErrorCodes:        []string{"CANNOT_UPDATE_TEST_NAME", "OPERATION_IN_PROGRESS"},
+							TimeoutSeconds:    600,
+							MinTimeoutSeconds: 30,
+						},
+					},
+					Read: &codespec.APIOperation{
+						HTTPMethod: "GET",
+						Path:       "/api/v1/testname/{projectId}",
+					},
+					Delete: &codespec.APIOperation{
+						HTTPMethod: "DELETE",
+						Path:       "/api/v1/testname/{projectId}",
+						Retry: &codespec.Retry{
+							StatusCodes:       []int{409},
+							MaxAttempts:       3,
+							TimeoutSeconds:    120,
+							MinTimeoutSeconds: 10,
+						},
+					},
+					VersionHeader: "application/vnd.atlas.2024-05-30+json",
+				},
+			},
+			goldenFileName: "retry-configuration",
+		},
+		"Defining operation lock to serialize create update and delete": {
+			inputModel: codespec.Resource{
+				Name:          "test_name",
+				PackageName:   "testname",
+				OperationLock: "project:{projectId}:networking",
+				Schema: &codespec.Schema{
+					Attributes: codespec.Attributes{
+						{
+							TFSchemaName: "project_id",
+							TFModelName:  "ProjectId",
+						},
+						{
+							TFSchemaName: "peer_id",
+							TFModelName:  "PeerId",
+						},
+					},
+				},
+				Operations: codespec.APIOperations{
+					Create: &codespec.APIOperation{
+						HTTPMethod: "POST",
+						Path:       "/api/v1/testname/{projectId}",
+					},
+					Update: &codespec.APIOperation{
+						HTTPMethod: "PATCH",
+						Path:       "/api/v1/testname/{projectId}/{peerId}",
+					},
+					Read: &codespec.APIOperation{
+						HTTPMethod: "GET",
+						Path:       "/api/v1/testname/{projectId}/{peerId}",
+					},
+					Delete: &codespec.APIOperation{
+						HTTPMethod: "DELETE",
+						Path:       "/api/v1/testname/{projectId}/{peerId}",
+					},
+					VersionHeader: "application/vnd.atlas.2024-05-30+json",
+				},
+			},
+			goldenFileName: "operation-lock",
+		},
 		"Defining static request body in delete operation with resets to defaults": {
 			inputModel: codespec.Resource{
 				Name:        "test_name",
@@ -125,7 +125,7 @@ func TestDataSourceSchemaGenerationFromCodeSpec(t *testing.T) {
 			},
 			goldenFileName: "ds-custom-types-attributes",
 		},
-		// Nested list of JSON with no sibling scalar jsontypes.NormalizedType (failed_indexes
+		// Nested list of JSON with no sibling scalar customtypes.JSONType (failed_indexes
 		// on mongodbatlas_cloud_backup_collection_restore_job_collection).
 		"JSON collection element types": {
 			inputModel: codespec.Resource{
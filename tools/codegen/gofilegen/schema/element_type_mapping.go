@@ -7,15 +7,15 @@ import (
 )
 
 const (
-	typesImportStatement     = "github.com/hashicorp/terraform-plugin-framework/types"
-	jsontypesImportStatement = "github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	typesImportStatement       = "github.com/hashicorp/terraform-plugin-framework/types"
+	customTypesImportStatement = "github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen/customtypes"
 )
 
 func ElementTypeProperty(elementType codespec.ElemType) CodeStatement {
 	result := codespec.ElementTypeToSchemaString[elementType]
 	imports := []string{typesImportStatement}
 	if elementType == codespec.CustomTypeJSON {
-		imports = []string{jsontypesImportStatement}
+		imports = []string{customTypesImportStatement}
 	}
 	return CodeStatement{
 		Code:    fmt.Sprintf("ElementType: %s", result),
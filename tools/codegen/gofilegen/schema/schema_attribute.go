@@ -227,11 +227,8 @@ func commonProperties(attr *codespec.Attribute, typeStatements *TypeSpecificStat
 	if attr.CustomType != nil {
 		var imports []string
 		for _, pkg := range attr.CustomType.Packages {
-			switch pkg {
-			case codespec.JSONTypesPkg:
-				imports = append(imports, jsontypesImportStatement)
-			case codespec.CustomTypesPkg:
-				imports = append(imports, "github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen/customtypes")
+			if pkg == codespec.CustomTypesPkg {
+				imports = append(imports, customTypesImportStatement)
 			}
 		}
 
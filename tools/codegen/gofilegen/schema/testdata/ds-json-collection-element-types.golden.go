@@ -7,7 +7,6 @@ import (
 
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen/customtypes"
 )
@@ -27,20 +26,20 @@ func DataSourceSchema(ctx context.Context) dsschema.Schema {
 					"failed_indexes": dsschema.ListAttribute{
 						Computed:            true,
 						MarkdownDescription: "list of index specifications that failed to build",
-						CustomType:          customtypes.NewListType[jsontypes.Normalized](ctx),
-						ElementType:         jsontypes.NormalizedType{},
+						CustomType:          customtypes.NewListType[customtypes.JSONValue](ctx),
+						ElementType:         customtypes.JSONType{},
 					},
 					"index_options": dsschema.MapAttribute{
 						Computed:            true,
 						MarkdownDescription: "map of index options",
-						CustomType:          customtypes.NewMapType[jsontypes.Normalized](ctx),
-						ElementType:         jsontypes.NormalizedType{},
+						CustomType:          customtypes.NewMapType[customtypes.JSONValue](ctx),
+						ElementType:         customtypes.JSONType{},
 					},
 					"index_tags": dsschema.SetAttribute{
 						Computed:            true,
 						MarkdownDescription: "set of index tags",
-						CustomType:          customtypes.NewSetType[jsontypes.Normalized](ctx),
-						ElementType:         jsontypes.NormalizedType{},
+						CustomType:          customtypes.NewSetType[customtypes.JSONValue](ctx),
+						ElementType:         customtypes.JSONType{},
 					},
 					"state": dsschema.StringAttribute{
 						Computed:            true,
@@ -57,8 +56,8 @@ type TFDSModel struct {
 	IndexStatus customtypes.ObjectValue[TFDSIndexStatusModel] `tfsdk:"index_status"`
 }
 type TFDSIndexStatusModel struct {
-	FailedIndexes customtypes.ListValue[jsontypes.Normalized] `tfsdk:"failed_indexes"`
-	IndexOptions  customtypes.MapValue[jsontypes.Normalized]  `tfsdk:"index_options"`
-	IndexTags     customtypes.SetValue[jsontypes.Normalized]  `tfsdk:"index_tags"`
-	State         types.String                                `tfsdk:"state"`
+	FailedIndexes customtypes.ListValue[customtypes.JSONValue] `tfsdk:"failed_indexes"`
+	IndexOptions  customtypes.MapValue[customtypes.JSONValue]  `tfsdk:"index_options"`
+	IndexTags     customtypes.SetValue[customtypes.JSONValue]  `tfsdk:"index_tags"`
+	State         types.String                                 `tfsdk:"state"`
 }
@@ -5,9 +5,9 @@ package testname
 import (
 	"context"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/autogen/customtypes"
 )
 
 func ResourceSchema(ctx context.Context) schema.Schema {
@@ -45,7 +45,7 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 			"json_attr": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "json description",
-				CustomType:          jsontypes.NormalizedType{},
+				CustomType:          customtypes.JSONType{},
 			},
 			"sensitive_string_attr": schema.StringAttribute{
 				Required:            true,
@@ -57,13 +57,13 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type TFModel struct {
-	StringAttr                 types.String         `tfsdk:"string_attr"`
-	BoolAttr                   types.Bool           `tfsdk:"bool_attr"`
-	IntAttr                    types.Int64          `tfsdk:"int_attr"`
-	FloatAttr                  types.Float64        `tfsdk:"float_attr"`
-	NumberAttr                 types.Number         `tfsdk:"number_attr"`
-	AttrNotIncludedInReqBodies types.String         `tfsdk:"attr_not_included_in_req_bodies" autogen:"omitjson"`
-	AttrOnlyInPostReqBodies    types.String         `tfsdk:"attr_only_in_post_req_bodies" autogen:"omitjsonupdate"`
-	JsonAttr                   jsontypes.Normalized `tfsdk:"json_attr"`
-	SensitiveStringAttr        types.String         `tfsdk:"sensitive_string_attr" autogen:"sensitive,omitjsonupdate"`
+	StringAttr                 types.String          `tfsdk:"string_attr"`
+	BoolAttr                   types.Bool            `tfsdk:"bool_attr"`
+	IntAttr                    types.Int64           `tfsdk:"int_attr"`
+	FloatAttr                  types.Float64         `tfsdk:"float_attr"`
+	NumberAttr                 types.Number          `tfsdk:"number_attr"`
+	AttrNotIncludedInReqBodies types.String          `tfsdk:"attr_not_included_in_req_bodies" autogen:"omitjson"`
+	AttrOnlyInPostReqBodies    types.String          `tfsdk:"attr_only_in_post_req_bodies" autogen:"omitjsonupdate"`
+	JsonAttr                   customtypes.JSONValue `tfsdk:"json_attr"`
+	SensitiveStringAttr        types.String          `tfsdk:"sensitive_string_attr" autogen:"sensitive,omitjsonupdate"`
 }